@@ -14,3 +14,7 @@ func errorf(t T, msg string, args ...any) {
 	t.Helper()
 	t.Errorf(msg, args...)
 }
+
+func (g *group) Errorf(msg string, args ...any) {
+	g.record(msg, args...)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/shoenig/test/internal/assertions"
+	"github.com/shoenig/test/wait"
+)
+
+// Eventually runs f repeatedly until an attempt makes no failing assertions
+// against the T given to f, or until the wait.InitialSuccess constraint made of
+// opts is exceeded. Failures of assertions made during an attempt are recorded
+// rather than reported; if no attempt passes, the failures of the last attempt
+// are reported along with the history of attempts.
+//
+// Useful for replacing hand-rolled polling loops, where the condition being
+// waited on is best expressed as normal assertions.
+//
+// Example,
+//
+//	Eventually(t, func(c T) {
+//	  Eq(c, "running", job.Status())
+//	}, wait.Timeout(10*time.Second), wait.Gap(100*time.Millisecond))
+func Eventually(t T, f func(c T), opts ...wait.Option) {
+	t.Helper()
+	a := new(attempts)
+	wc := wait.InitialSuccess(slices.Concat(opts, []wait.Option{a.option(f)})...)
+	invoke(t, assertions.Eventually(wc, a.failures))
+}
+
+// Consistently runs f repeatedly, asserting every attempt makes no failing
+// assertions against the T given to f until the wait.ContinualSuccess
+// constraint made of opts is satisfied. If an attempt fails, its failures are
+// reported along with the history of attempts.
+//
+// Example,
+//
+//	Consistently(t, func(c T) {
+//	  Eq(c, 3, pool.Size())
+//	}, wait.Timeout(time.Second), wait.Gap(50*time.Millisecond))
+func Consistently(t T, f func(c T), opts ...wait.Option) {
+	t.Helper()
+	a := new(attempts)
+	wc := wait.ContinualSuccess(slices.Concat(opts, []wait.Option{a.option(f)})...)
+	invoke(t, assertions.Consistently(wc, a.failures))
+}
+
+// attempts records the failures of the latest attempt made by Eventually or
+// Consistently. Attempts abandoned by wait.AttemptTimeout may still be running,
+// hence the lock.
+type attempts struct {
+	lock    sync.Mutex
+	current int // number of the latest attempt
+	last    []*assertions.Failure
+}
+
+// option returns the wait.Option making each attempt of f against a new group.
+// An attempt with failures produces an error of the first failure message, so
+// distinct failures are reflected in the history of attempts. A panic of f is
+// recovered as a failure of the attempt.
+func (a *attempts) option(f func(c T)) wait.Option {
+	return wait.TestFunc(func() (bool, error) {
+		n := a.begin()
+		g := new(group)
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					g.record("attempt panicked: %v", r)
+				}
+			}()
+			f(g)
+		}()
+		a.end(n, g.failures)
+
+		switch n := len(g.failures); n {
+		case 0:
+			return true, nil
+		case 1:
+			return false, errors.New(g.failures[0].Message)
+		default:
+			return false, fmt.Errorf("%s (and %d more)", g.failures[0].Message, n-1)
+		}
+	})
+}
+
+// begin starts a new attempt, returning its number.
+func (a *attempts) begin() int {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.current++
+	a.last = nil
+	return a.current
+}
+
+// end records the failures of attempt n, unless a later attempt has begun since,
+// i.e. attempt n was abandoned.
+func (a *attempts) end(n int, failures []*assertions.Failure) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if n == a.current {
+		a.last = failures
+	}
+}
+
+func (a *attempts) failures() []*assertions.Failure {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.last
+}
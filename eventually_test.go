@@ -0,0 +1,133 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/wait"
+)
+
+func TestEventually(t *testing.T) {
+	tc := newCase(t, `expected assertions to pass eventually`)
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		for _, exp := range []string{
+			"↪ attempts: 4\n",
+			"↪ distinct errors: 1\n",
+			"↪   [attempt 4] expected equality via cmp.Equal function\n",
+			"↪ last attempt ↷\n  eventually_test.go:",
+		} {
+			if !strings.Contains(tc.capture, exp) {
+				t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+			}
+		}
+	})
+
+	Eventually(tc, func(c T) {
+		Eq(c, 1, 2)
+	}, wait.Attempts(3), wait.Gap(1*time.Millisecond))
+}
+
+func TestEventually_summary(t *testing.T) {
+	tc := newCase(t, `2 assertions failed in group`)
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		exp := "↪   [attempt 2] expected equality via cmp.Equal function (and 1 more)\n"
+		if !strings.Contains(tc.capture, exp) {
+			t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+		}
+	})
+
+	Eventually(tc, func(c T) {
+		Eq(c, 1, 2)
+		True(c, false)
+	}, wait.Attempts(1), wait.Gap(1*time.Millisecond))
+}
+
+func TestEventually_pass(t *testing.T) {
+	tc := newCase(t, ``)
+	t.Cleanup(tc.assertNot)
+
+	i := 0
+	Eventually(tc, func(c T) {
+		i++
+		Eq(c, 3, i)
+	}, wait.Gap(1*time.Millisecond))
+}
+
+func TestEventually_panic(t *testing.T) {
+	tc := newCase(t, `expected assertions to pass eventually`)
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		exp := "attempt panicked: runtime error: invalid memory address or nil pointer dereference"
+		if !strings.Contains(tc.capture, exp) {
+			t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+		}
+	})
+
+	var p *Person
+	Eventually(tc, func(c T) {
+		Eq(c, "alice", p.Name)
+	}, wait.Attempts(2), wait.Gap(1*time.Millisecond))
+}
+
+func TestEventually_panicRecovered(t *testing.T) {
+	tc := newCase(t, ``)
+	t.Cleanup(tc.assertNot)
+
+	var p *Person
+	i := 0
+	Eventually(tc, func(c T) {
+		if i++; i == 3 {
+			p = &Person{Name: "alice"}
+		}
+		Eq(c, "alice", p.Name)
+	}, wait.Gap(1*time.Millisecond))
+}
+
+func TestEventually_abandoned(t *testing.T) {
+	a := new(attempts)
+	abandoned, current := a.begin(), a.begin()
+	a.end(current, []*Failure{{Message: "current"}})
+	a.end(abandoned, []*Failure{{Message: "abandoned"}})
+
+	if last := a.failures(); len(last) != 1 || last[0].Message != "current" {
+		t.Fatalf("expected failures of the current attempt, got %v", last)
+	}
+}
+
+func TestConsistently(t *testing.T) {
+	tc := newCase(t, `expected assertions to pass consistently`)
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		for _, exp := range []string{
+			"↪ attempts: 3\n",
+			"↪ held:",
+			"↪ last attempt ↷\n  eventually_test.go:",
+			"expected 3 < 3",
+		} {
+			if !strings.Contains(tc.capture, exp) {
+				t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+			}
+		}
+	})
+
+	i := 0
+	Consistently(tc, func(c T) {
+		i++
+		Less(c, 3, i)
+	}, wait.Attempts(10), wait.Gap(1*time.Millisecond))
+}
+
+func TestConsistently_pass(t *testing.T) {
+	tc := newCase(t, ``)
+	t.Cleanup(tc.assertNot)
+
+	Consistently(tc, func(c T) {
+		Eq(c, 1, 1)
+	}, wait.Attempts(3), wait.Gap(1*time.Millisecond))
+}
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"maps"
 	"math"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"testing/fstest"
@@ -20,7 +22,9 @@ import (
 var t = new(myT)
 
 // myT is a substitute for testing.T for use in examples
-type myT struct{}
+type myT struct {
+	name string // of the example, locating its golden files
+}
 
 func (t *myT) Errorf(s string, args ...any) {
 	s = fmt.Sprintf(s, args...)
@@ -36,6 +40,15 @@ func (t *myT) Helper() {
 	// nothing
 }
 
+func (t *myT) Cleanup(f func()) {
+	// examples run cleanup immediately
+	f()
+}
+
+func (t *myT) Name() string {
+	return t.name
+}
+
 type myContainer[T comparable] struct {
 	items map[T]struct{}
 }
@@ -179,6 +192,29 @@ func ExampleBetweenExclusive() {
 	// Output:
 }
 
+func ExampleChanLen() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ChanLen(t, 2, ch)
+	// Output:
+}
+
+func ExampleClosed() {
+	ch := make(chan int)
+	close(ch)
+	Closed(t, ch, 1*time.Second)
+	// Output:
+}
+
+func ExampleConsistently() {
+	Consistently(t, func(c T) {
+		// every attempt must pass until the attempts are exhausted
+		Eq(c, 3, len("foo"))
+	}, wait.Attempts(3), wait.Gap(10*time.Millisecond))
+	// Output:
+}
+
 func ExampleContains() {
 	// container implements .Contains method
 	container := newContainer(2, 4, 6, 8)
@@ -237,6 +273,15 @@ func ExampleDirNotExistsFS() {
 	// Output:
 }
 
+func ExampleDrains() {
+	ch := make(chan int, 3)
+	for i := range 3 {
+		ch <- i
+	}
+	Drains(t, ch, 3, 1*time.Second)
+	// Output:
+}
+
 func ExampleEmpty() {
 	// container implements .Empty method
 	container := newContainer[string]()
@@ -284,6 +329,23 @@ func ExampleEqOp() {
 	// Output:
 }
 
+func ExampleEqPartial() {
+	type address struct {
+		Street string
+		City   string
+	}
+	type person struct {
+		Name    string
+		Age     int
+		Address address
+	}
+	exp := person{Name: "Alice", Address: address{City: "Paris"}}
+	val := person{Name: "Alice", Age: 33, Address: address{Street: "Rue", City: "Paris"}}
+	EqPartial(t, exp, val)
+	EqPartial(t, exp, val, Fields(".Name", ".Address.City"))
+	// Output:
+}
+
 func ExampleEqual() {
 	// score implements .Equal method
 	Equal(t, score(1000), score(1000))
@@ -319,6 +381,16 @@ func ExampleErrorAs() {
 	// Output: e1
 }
 
+func ExampleEventually() {
+	i := 0
+	Eventually(t, func(c T) {
+		// retried until an attempt makes no failing assertions
+		i++
+		Eq(c, 3, i)
+	}, wait.Timeout(1*time.Second), wait.Gap(10*time.Millisecond))
+	// Output:
+}
+
 func ExampleFalse() {
 	False(t, 1 == int('a'))
 	// Output:
@@ -361,6 +433,22 @@ func ExampleFilePathValid() {
 	// Output:
 }
 
+func ExampleGolden() {
+	t := &myT{name: "ExampleGolden"}
+
+	// compares with testdata/ExampleGolden/greeting.golden
+	Golden(t, "greeting", []byte("hello, world!\n"))
+	// Output:
+}
+
+func ExampleGoldenJSON() {
+	t := &myT{name: "ExampleGoldenJSON"}
+
+	// compares with testdata/ExampleGoldenJSON/person.golden
+	GoldenJSON(t, "person", map[string]any{"Name": "alice", "Age": 42})
+	// Output:
+}
+
 func ExampleGreater() {
 	Greater(t, 30, 42)
 	// Output:
@@ -371,6 +459,14 @@ func ExampleGreaterEq() {
 	// Output:
 }
 
+func ExampleGroup() {
+	Group(t, func(g T) {
+		Eq(g, "alice", "alice")
+		Positive(g, 42)
+	})
+	// Output:
+}
+
 func ExampleInDelta() {
 	InDelta(t, 30.5, 30.54, .1)
 	// Output:
@@ -383,6 +479,20 @@ func ExampleInDeltaSlice() {
 	// Output:
 }
 
+func ExampleJSONContains() {
+	body := `{"id": 7, "name": "widget", "tags": ["a", "b"], "price": {"amount": 10, "currency": "EUR"}}`
+	JSONContains(t, body, `{"name": "widget", "price": {"currency": "EUR"}}`)
+	JSONContains(t, body, `{"tags": ["b", "a"]}`, UnorderedArrays())
+	// Output:
+}
+
+func ExampleJSONPath() {
+	body := `{"items": [{"name": "widget", "price": 10}]}`
+	JSONPath(t, body, "$.items[0].name", "widget")
+	JSONPath(t, body, "$.items[0].price", 10)
+	// Output:
+}
+
 func ExampleLen() {
 	nums := []int{1, 3, 5, 9}
 	Len(t, 4, nums)
@@ -590,6 +700,14 @@ func ExampleMapNotEmpty() {
 	// Output:
 }
 
+func ExampleMatch() {
+	isEven := NewMatcher("is even", func(n int) bool {
+		return n%2 == 0
+	})
+	Match(t, []int{2, 4, 6}, Each(AllOf(isEven, Not(EqualTo(3)))))
+	// Output:
+}
+
 func ExampleMax() {
 	s := scores{89, 88, 91, 90, 87}
 	Max[score](t, 91, s)
@@ -619,6 +737,17 @@ func ExampleNoError() {
 	// Output:
 }
 
+func ExampleNoGoroutineLeaks() {
+	NoGoroutineLeaks(t)
+	// Output:
+}
+
+func ExampleNoReceive() {
+	ch := make(chan int)
+	NoReceive(t, ch, 10*time.Millisecond)
+	// Output:
+}
+
 func ExampleNonNegative() {
 	NonNegative(t, 4)
 	// Output:
@@ -701,11 +830,53 @@ func ExampleNotZeroValue() {
 	// Output:
 }
 
+func ExamplePath() {
+	type config struct {
+		Servers []struct {
+			Host string
+			Tags map[string]string
+		}
+	}
+	c := &config{Servers: []struct {
+		Host string
+		Tags map[string]string
+	}{{Host: "db1", Tags: map[string]string{"role": "primary"}}}}
+	Path(t, c, "Servers[0].Host", "db1")
+	Path(t, c, `Servers[0].Tags["role"]`, "primary")
+	// Output:
+}
+
+func ExamplePathExists() {
+	doc := map[string]any{"metadata": map[string]any{"name": "web"}}
+	PathExists(t, doc, "metadata.name")
+	// Output:
+}
+
+func ExamplePathNotExists() {
+	doc := map[string]any{"metadata": map[string]any{"name": "web"}}
+	PathNotExists(t, doc, "metadata.labels")
+	// Output:
+}
+
 func ExamplePositive() {
 	Positive(t, 42)
 	// Output:
 }
 
+func ExampleReceives() {
+	ch := make(chan string, 1)
+	ch <- "hello"
+	Receives(t, ch, "hello", 1*time.Second)
+	// Output:
+}
+
+func ExampleReceivesWithin() {
+	ch := make(chan string, 1)
+	ch <- "hello"
+	ReceivesWithin(t, ch, 1*time.Second)
+	// Output:
+}
+
 func ExampleRegexCompiles() {
 	RegexCompiles(t, `[a-z]{7}`)
 	// Output:
@@ -722,6 +893,41 @@ func ExampleRegexMatch() {
 	// Output:
 }
 
+func ExampleSeqAscending() {
+	SeqAscending(t, slices.Values([]int{1, 3, 7}))
+	// Output:
+}
+
+func ExampleSeqContains() {
+	SeqContains(t, maps.Keys(map[string]int{"one": 1, "two": 2}), "two")
+	// Output:
+}
+
+func ExampleSeqContainsAll() {
+	SeqContainsAll(t, maps.Values(map[string]int{"one": 1, "two": 2}), []int{2, 1})
+	// Output:
+}
+
+func ExampleSeqEmpty() {
+	SeqEmpty(t, slices.Values([]string{}))
+	// Output:
+}
+
+func ExampleSeqEq() {
+	SeqEq(t, []string{"a", "b"}, slices.Values([]string{"a", "b"}))
+	// Output:
+}
+
+func ExampleSeqLen() {
+	SeqLen(t, 2, strings.SplitSeq("a,b", ","))
+	// Output:
+}
+
+func ExampleSeq2Eq() {
+	Seq2Eq(t, slices.All([]string{"a", "b"}), slices.All([]string{"a", "b"}))
+	// Output:
+}
+
 func ExampleSize() {
 	c := newContainer("pie", "brownie", "cake", "cookie")
 	Size(t, 4, c)
@@ -879,6 +1085,27 @@ func ExampleSliceEqOp() {
 	// Output:
 }
 
+func ExampleSliceEqUnordered() {
+	s1 := []string{"a", "b", "b", "c"}
+	s2 := []string{"b", "c", "a", "b"}
+	SliceEqUnordered(t, s1, s2)
+	// Output:
+}
+
+func ExampleSliceEqUnorderedFunc() {
+	s1 := []string{"A", "b", "C"}
+	s2 := []string{"c", "a", "B"}
+	SliceEqUnorderedFunc(t, s1, s2, strings.EqualFold)
+	// Output:
+}
+
+func ExampleSliceEqUnorderedOp() {
+	s1 := []int{1, 3, 3, 7}
+	s2 := []int{7, 3, 1, 3}
+	SliceEqUnorderedOp(t, s1, s2)
+	// Output:
+}
+
 func ExampleSliceLen() {
 	SliceLen(t, 4, []float64{32, 1.2, 0.01, 9e4})
 	// Output:
@@ -909,6 +1136,11 @@ func ExampleSliceNotEmpty() {
 	// Output:
 }
 
+func ExampleSnapshot() {
+	Snapshot(t, strings.Repeat("ab", 3), Inline(`ababab`))
+	// Output:
+}
+
 func ExampleStrContains() {
 	StrContains(t, "Visit https://github.com today!", "https://")
 	// Output:
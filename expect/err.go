@@ -0,0 +1,62 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package expect
+
+import (
+	"github.com/shoenig/test"
+)
+
+// An ErrValue is the subject of assertions on an error.
+type ErrValue struct {
+	t        T
+	err      error
+	settings []Setting
+}
+
+// Err creates an ErrValue for making assertions about err.
+func Err(t T, err error, settings ...Setting) *ErrValue {
+	return &ErrValue{t: t, err: err, settings: settings}
+}
+
+// Nil asserts the error is nil.
+func (e *ErrValue) Nil() *ErrValue {
+	e.t.Helper()
+	test.NoError(e.t, e.err, e.settings...)
+	return e
+}
+
+// NotNil asserts the error is not nil.
+func (e *ErrValue) NotNil() *ErrValue {
+	e.t.Helper()
+	test.Error(e.t, e.err, e.settings...)
+	return e
+}
+
+// Eq asserts the error message is exactly msg.
+func (e *ErrValue) Eq(msg string) *ErrValue {
+	e.t.Helper()
+	test.EqError(e.t, e.err, msg, e.settings...)
+	return e
+}
+
+// Contains asserts the error message contains sub.
+func (e *ErrValue) Contains(sub string) *ErrValue {
+	e.t.Helper()
+	test.ErrorContains(e.t, e.err, sub, e.settings...)
+	return e
+}
+
+// Is asserts errors.Is(err, target).
+func (e *ErrValue) Is(target error) *ErrValue {
+	e.t.Helper()
+	test.ErrorIs(e.t, e.err, target, e.settings...)
+	return e
+}
+
+// As asserts errors.As(err, target), setting target to the matching error.
+func (e *ErrValue) As(target any) *ErrValue {
+	e.t.Helper()
+	test.ErrorAs[error](e.t, e.err, target, e.settings...)
+	return e
+}
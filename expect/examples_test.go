@@ -0,0 +1,49 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package expect
+
+import (
+	"fmt"
+	"io/fs"
+)
+
+var t = new(myT)
+
+// myT is a substitute for testing.T for use in examples
+type myT struct{}
+
+func (t *myT) Errorf(s string, args ...any) {
+	s = fmt.Sprintf(s, args...)
+	fmt.Println(s)
+}
+
+func (t *myT) Helper() {
+	// nothing
+}
+
+func ExampleThat() {
+	That(t, 42).Eq(42).NotZeroValue()
+	// Output:
+}
+
+func ExampleSlice() {
+	Slice(t, []int{2, 4, 6}).Len(3).Contains(4).Ascending()
+	// Output:
+}
+
+func ExampleMap() {
+	Map(t, map[string]int{"one": 1, "two": 2}).ContainsKeys("one", "two")
+	// Output:
+}
+
+func ExampleStr() {
+	Str(t, "hello world").HasPrefix("hello").Contains("o w")
+	// Output:
+}
+
+func ExampleErr() {
+	err := fmt.Errorf("read failed: %w", fs.ErrClosed)
+	Err(t, err).Is(fs.ErrClosed).Contains("read failed")
+	// Output:
+}
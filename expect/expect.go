@@ -0,0 +1,110 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+// Package expect provides a fluent, strongly typed assertions API built upon
+// the functions of the test package.
+//
+// Each assertion is a method on a value wrapping the subject under test, so the
+// order of arguments is directed by the type of the subject rather than by
+// convention. Assertions may be chained, and like the test package a failed
+// assertion marks the test case as failed while allowing it to continue.
+//
+//	expect.That(t, user.Name).Eq("alice").NotZeroValue()
+//	expect.Slice(t, ids).Len(3).Contains(7).Ascending()
+//	expect.Map(t, labels).ContainsKeys("region", "zone")
+//	expect.Err(t, err).Is(fs.ErrNotExist).Contains("config.hcl")
+//
+// Any Setting given when creating the subject applies to each assertion made on it.
+package expect
+
+import (
+	"github.com/shoenig/test"
+)
+
+// T is the minimal set of functions to be implemented by any testing framework
+// compatible with the expect package.
+type T interface {
+	Helper()
+	Errorf(string, ...any)
+}
+
+// A Setting changes the behavior of a test case assertion.
+//
+// Use the functions of the test package (e.g. test.Cmp, test.Sprintf) to
+// create a Setting.
+type Setting = test.Setting
+
+// A Value is the subject of assertions on a value of any type.
+type Value[A any] struct {
+	t        T
+	value    A
+	settings []Setting
+}
+
+// That creates a Value for making assertions about value.
+func That[A any](t T, value A, settings ...Setting) *Value[A] {
+	return &Value[A]{t: t, value: value, settings: settings}
+}
+
+// Eq asserts the value is equal to exp using cmp.Equal.
+func (v *Value[A]) Eq(exp A) *Value[A] {
+	v.t.Helper()
+	test.Eq(v.t, exp, v.value, v.settings...)
+	return v
+}
+
+// NotEq asserts the value is not equal to exp using cmp.Equal.
+func (v *Value[A]) NotEq(exp A) *Value[A] {
+	v.t.Helper()
+	test.NotEq(v.t, exp, v.value, v.settings...)
+	return v
+}
+
+// EqFunc asserts the value is equal to exp using eq.
+func (v *Value[A]) EqFunc(exp A, eq func(a, b A) bool) *Value[A] {
+	v.t.Helper()
+	test.EqFunc(v.t, exp, v.value, eq, v.settings...)
+	return v
+}
+
+// NotEqFunc asserts the value is not equal to exp using eq.
+func (v *Value[A]) NotEqFunc(exp A, eq func(a, b A) bool) *Value[A] {
+	v.t.Helper()
+	test.NotEqFunc(v.t, exp, v.value, eq, v.settings...)
+	return v
+}
+
+// ZeroValue asserts the value is the zero value for its type.
+func (v *Value[A]) ZeroValue() *Value[A] {
+	v.t.Helper()
+	test.ZeroValue(v.t, v.value, v.settings...)
+	return v
+}
+
+// NotZeroValue asserts the value is not the zero value for its type.
+func (v *Value[A]) NotZeroValue() *Value[A] {
+	v.t.Helper()
+	test.NotZeroValue(v.t, v.value, v.settings...)
+	return v
+}
+
+// Nil asserts the value is nil.
+func (v *Value[A]) Nil() *Value[A] {
+	v.t.Helper()
+	test.Nil(v.t, v.value, v.settings...)
+	return v
+}
+
+// NotNil asserts the value is not nil.
+func (v *Value[A]) NotNil() *Value[A] {
+	v.t.Helper()
+	test.NotNil(v.t, v.value, v.settings...)
+	return v
+}
+
+// Match asserts the value satisfies the Matcher m.
+func (v *Value[A]) Match(m test.Matcher[A]) *Value[A] {
+	v.t.Helper()
+	test.Match(v.t, v.value, m, v.settings...)
+	return v
+}
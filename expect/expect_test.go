@@ -0,0 +1,270 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package expect
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test"
+)
+
+type internalTest struct {
+	t        *testing.T
+	helper   bool
+	failures []string
+}
+
+func (it *internalTest) Helper() {
+	it.helper = true
+}
+
+func (it *internalTest) Errorf(s string, args ...any) {
+	msg := strings.TrimSpace(fmt.Sprintf(s, args...))
+	it.failures = append(it.failures, msg)
+	it.t.Log(msg)
+}
+
+func (it *internalTest) expect(messages ...string) {
+	it.t.Helper()
+	if !it.helper {
+		it.t.Fatal("should be marked as helper")
+	}
+	if len(it.failures) != len(messages) {
+		it.t.Fatalf("expected %d failures, got %d", len(messages), len(it.failures))
+	}
+	for i, msg := range messages {
+		if !strings.Contains(it.failures[i], msg) {
+			it.t.Fatalf("expected message %q in output, got %q", msg, it.failures[i])
+		}
+	}
+}
+
+func newCase(t *testing.T) *internalTest {
+	return &internalTest{t: t}
+}
+
+type score int
+
+func (s score) Less(o score) bool {
+	return s < o
+}
+
+type point struct {
+	x, y int
+}
+
+func TestThat(t *testing.T) {
+	tc := newCase(t)
+
+	That(tc, 42).Eq(42).NotEq(7).NotZeroValue()
+	That(tc, "").ZeroValue()
+	That[*int](tc, nil).Nil()
+	That(tc, &point{}).NotNil()
+	That(tc, 3).EqFunc(5, func(a, b int) bool { return a%2 == b%2 })
+	That(tc, 3).NotEqFunc(4, func(a, b int) bool { return a%2 == b%2 })
+	tc.expect()
+}
+
+func TestThat_fail(t *testing.T) {
+	tc := newCase(t)
+
+	That(tc, 42).Eq(43).ZeroValue()
+	tc.expect(
+		"expected equality via cmp.Equal function",
+		"expected zero via cmp.Equal function",
+	)
+}
+
+func TestThat_Match(t *testing.T) {
+	tc := newCase(t)
+
+	even := test.NewMatcher("is even", func(n int) bool { return n%2 == 0 })
+	That(tc, 4).Match(even)
+	That(tc, 5).Match(even)
+	tc.expect("expected value to satisfy matcher")
+}
+
+func TestThat_caller(t *testing.T) {
+	tc := newCase(t)
+
+	That(tc, 1).Eq(2)
+	tc.expect("expect_test.go:")
+}
+
+func TestSlice(t *testing.T) {
+	tc := newCase(t)
+
+	Slice(tc, []int{1, 3, 7}).
+		Len(3).
+		NotEmpty().
+		Contains(3).
+		NotContains(4).
+		ContainsAll(7, 1, 3).
+		ContainsSubset(7).
+		Ascending().
+		Eq([]int{1, 3, 7})
+	Slice(tc, []string{"c", "b", "a"}).Descending()
+	Slice(tc, []score{1, 2, 2}).Ascending()
+	Slice[int](tc, nil).Empty()
+	tc.expect()
+}
+
+func TestSlice_fail(t *testing.T) {
+	tc := newCase(t)
+
+	Slice(tc, []int{1, 9, 7}).Len(2).Ascending()
+	tc.expect(
+		"expected slice to be different length",
+		"expected compare(slice[1], slice[2]) <= 0",
+	)
+}
+
+func TestSlice_unordered(t *testing.T) {
+	tc := newCase(t)
+
+	Slice(tc, []point{{1, 2}}).Ascending()
+	Slice(tc, []point{{1, 2}}).Descending()
+	tc.expect(
+		"↪ expected: elements of an ordered type\n↪   actual: elements of type expect.point are not ordered; use a Func variant",
+		"↪ expected: elements of an ordered type\n↪   actual: elements of type expect.point are not ordered; use a Func variant",
+	)
+}
+
+// recorder is a test.Reporter recording failures as data.
+type recorder struct {
+	failures []*test.Failure
+}
+
+func (r *recorder) Report(failures []*test.Failure) string {
+	r.failures = append(r.failures, failures...)
+	return "recorded"
+}
+
+func TestSlice_unorderedKind(t *testing.T) {
+	r := new(recorder)
+	previous := test.SetReporter(r)
+	t.Cleanup(func() { test.SetReporter(previous) })
+
+	tc := newCase(t)
+	Slice(tc, []point{{1, 2}}).Ascending()
+	Slice(tc, []point{{1, 2}}).Descending()
+	tc.expect("recorded", "recorded")
+
+	for i, kind := range []string{"Ascending", "Descending"} {
+		if f := r.failures[i]; f.Kind != kind {
+			t.Fatalf("expected kind %q, got %q", kind, f.Kind)
+		}
+	}
+}
+
+func TestSlice_func(t *testing.T) {
+	tc := newCase(t)
+
+	less := func(a, b point) bool { return a.x < b.x }
+	Slice(tc, []point{{1, 1}, {2, 2}}).AscendingFunc(less)
+	Slice(tc, []point{{2, 2}, {1, 1}}).DescendingFunc(less)
+	Slice(tc, []point{{1, 1}}).ContainsFunc(point{1, 9}, func(a, b point) bool {
+		return a.x == b.x
+	})
+	tc.expect()
+}
+
+func TestMap(t *testing.T) {
+	tc := newCase(t)
+
+	m := map[string]int{"one": 1, "two": 2}
+	Map(tc, m).
+		Len(2).
+		NotEmpty().
+		ContainsKeys("one", "two").
+		NotContainsKeys("three").
+		ContainsValues(2).
+		NotContainsValues(3).
+		Eq(map[string]int{"one": 1, "two": 2})
+	Map(tc, map[int]bool{}).Empty()
+	tc.expect()
+}
+
+func TestMap_fail(t *testing.T) {
+	tc := newCase(t)
+
+	Map(tc, map[string]int{"one": 1}).ContainsKeys("one", "two").Len(3)
+	tc.expect(
+		"expected map to contain keys",
+		"expected map to be different length",
+	)
+}
+
+func TestMap_sources(t *testing.T) {
+	tc := newCase(t)
+
+	m, key := map[string]int{"one": 1}, "two"
+	wanted := []string{"one", "three"}
+	Map(tc, m).ContainsKeys("one", key)
+	Map(tc, m).ContainsKeys(wanted...)
+	Map(tc, m).ContainsKeys("two", "three")
+	tc.expect(
+		"↪ keys: \"one\", key\n",
+		"↪ keys: wanted...\n",
+		"expected map to contain keys",
+	)
+	if strings.Contains(tc.failures[2], "↪ keys:") {
+		t.Fatalf("expected no source of literal keys, got %q", tc.failures[2])
+	}
+}
+
+func TestStr(t *testing.T) {
+	tc := newCase(t)
+
+	Str(tc, "Hello, World").
+		Eq("Hello, World").
+		EqFold("hello, world").
+		Contains("lo, W").
+		ContainsFold("WORLD").
+		NotContains("moon").
+		Count("o", 2).
+		HasPrefix("Hello").
+		NotHasPrefix("World").
+		HasSuffix("World").
+		NotHasSuffix("Hello").
+		Matches(regexp.MustCompile(`^H.*d$`))
+	tc.expect()
+}
+
+func TestStr_fail(t *testing.T) {
+	tc := newCase(t)
+
+	Str(tc, "abc").HasPrefix("b").Contains("z")
+	tc.expect(
+		"expected string to have prefix",
+		"expected string to contain substring; it does not",
+	)
+}
+
+func TestErr(t *testing.T) {
+	tc := newCase(t)
+
+	err := fmt.Errorf("open config.hcl: %w", fs.ErrNotExist)
+	Err(tc, err).NotNil().Is(fs.ErrNotExist).Contains("config.hcl").Eq("open config.hcl: file does not exist")
+	Err(tc, nil).Nil()
+
+	var pathErr *fs.PathError
+	Err(tc, fmt.Errorf("wrapped: %w", &fs.PathError{Op: "open"})).As(&pathErr)
+	tc.expect()
+}
+
+func TestErr_fail(t *testing.T) {
+	tc := newCase(t)
+
+	Err(tc, errors.New("oops")).Is(fs.ErrExist).Nil()
+	tc.expect(
+		"expected errors.Is match",
+		"expected nil error",
+	)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package expect
+
+import (
+	"github.com/shoenig/test"
+)
+
+// A MapValue is the subject of assertions on a map.
+type MapValue[K comparable, V any] struct {
+	t        T
+	m        map[K]V
+	settings []Setting
+}
+
+// Map creates a MapValue for making assertions about m.
+func Map[M ~map[K]V, K comparable, V any](t T, m M, settings ...Setting) *MapValue[K, V] {
+	return &MapValue[K, V]{t: t, m: map[K]V(m), settings: settings}
+}
+
+// Eq asserts the map contains the same key/val pairs as exp, using cmp.Equal
+// to compare vals.
+func (m *MapValue[K, V]) Eq(exp map[K]V) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapEq(m.t, exp, m.m, m.settings...)
+	return m
+}
+
+// EqFunc asserts the map contains the same key/val pairs as exp, using eq to
+// compare vals.
+func (m *MapValue[K, V]) EqFunc(exp map[K]V, eq func(a, b V) bool) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapEqFunc(m.t, exp, m.m, eq, m.settings...)
+	return m
+}
+
+// Len asserts the map is of size n.
+func (m *MapValue[K, V]) Len(n int) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapLen(m.t, n, m.m, m.settings...)
+	return m
+}
+
+// Empty asserts the map is empty.
+func (m *MapValue[K, V]) Empty() *MapValue[K, V] {
+	m.t.Helper()
+	test.MapEmpty(m.t, m.m, m.settings...)
+	return m
+}
+
+// NotEmpty asserts the map is not empty.
+func (m *MapValue[K, V]) NotEmpty() *MapValue[K, V] {
+	m.t.Helper()
+	test.MapNotEmpty(m.t, m.m, m.settings...)
+	return m
+}
+
+// ContainsKeys asserts the map contains each key in keys.
+func (m *MapValue[K, V]) ContainsKeys(keys ...K) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapContainsKeys(m.t, m.m, keys, m.settings...)
+	return m
+}
+
+// NotContainsKeys asserts the map does not contain any key in keys.
+func (m *MapValue[K, V]) NotContainsKeys(keys ...K) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapNotContainsKeys(m.t, m.m, keys, m.settings...)
+	return m
+}
+
+// ContainsValues asserts the map contains each val in vals, using cmp.Equal to
+// compare vals.
+func (m *MapValue[K, V]) ContainsValues(vals ...V) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapContainsValues(m.t, m.m, vals, m.settings...)
+	return m
+}
+
+// NotContainsValues asserts the map does not contain any val in vals, using
+// cmp.Equal to compare vals.
+func (m *MapValue[K, V]) NotContainsValues(vals ...V) *MapValue[K, V] {
+	m.t.Helper()
+	test.MapNotContainsValues(m.t, m.m, vals, m.settings...)
+	return m
+}
@@ -0,0 +1,198 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package expect
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+
+	"github.com/shoenig/test"
+)
+
+// A SliceValue is the subject of assertions on a slice.
+type SliceValue[A any] struct {
+	t        T
+	slice    []A
+	settings []Setting
+}
+
+// Slice creates a SliceValue for making assertions about slice.
+func Slice[A any](t T, slice []A, settings ...Setting) *SliceValue[A] {
+	return &SliceValue[A]{t: t, slice: slice, settings: settings}
+}
+
+// Eq asserts the slice is equal to exp using cmp.Equal.
+func (s *SliceValue[A]) Eq(exp []A) *SliceValue[A] {
+	s.t.Helper()
+	test.Eq(s.t, exp, s.slice, s.settings...)
+	return s
+}
+
+// EqFunc asserts each element of the slice satisfies eq for the corresponding
+// element in exp.
+func (s *SliceValue[A]) EqFunc(exp []A, eq func(a, b A) bool) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceEqFunc(s.t, exp, s.slice, eq, s.settings...)
+	return s
+}
+
+// Len asserts the slice is of length n.
+func (s *SliceValue[A]) Len(n int) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceLen(s.t, n, s.slice, s.settings...)
+	return s
+}
+
+// Empty asserts the slice is empty.
+func (s *SliceValue[A]) Empty() *SliceValue[A] {
+	s.t.Helper()
+	test.SliceEmpty(s.t, s.slice, s.settings...)
+	return s
+}
+
+// NotEmpty asserts the slice is not empty.
+func (s *SliceValue[A]) NotEmpty() *SliceValue[A] {
+	s.t.Helper()
+	test.SliceNotEmpty(s.t, s.slice, s.settings...)
+	return s
+}
+
+// Contains asserts item exists in the slice, using cmp.Equal to compare elements.
+func (s *SliceValue[A]) Contains(item A) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceContains(s.t, s.slice, item, s.settings...)
+	return s
+}
+
+// ContainsFunc asserts item exists in the slice, using eq to compare elements.
+func (s *SliceValue[A]) ContainsFunc(item A, eq func(a, b A) bool) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceContainsFunc(s.t, s.slice, item, eq, s.settings...)
+	return s
+}
+
+// NotContains asserts item does not exist in the slice, using cmp.Equal to
+// compare elements.
+func (s *SliceValue[A]) NotContains(item A) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceNotContains(s.t, s.slice, item, s.settings...)
+	return s
+}
+
+// ContainsAll asserts the slice and items contain the same elements, but in no
+// particular order, using cmp.Equal to compare elements.
+func (s *SliceValue[A]) ContainsAll(items ...A) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceContainsAll(s.t, s.slice, items, s.settings...)
+	return s
+}
+
+// ContainsSubset asserts the slice contains each item in items, in no particular
+// order, using cmp.Equal to compare elements.
+func (s *SliceValue[A]) ContainsSubset(items ...A) *SliceValue[A] {
+	s.t.Helper()
+	test.SliceContainsSubset(s.t, s.slice, items, s.settings...)
+	return s
+}
+
+// Ascending asserts slice[n] ≤ slice[n+1] for each element.
+//
+// The element type must be an integer, float, or string kind, or implement a
+// Less method. Otherwise use AscendingFunc.
+func (s *SliceValue[A]) Ascending() *SliceValue[A] {
+	s.t.Helper()
+	if compare, ok := comparator[A](); ok {
+		test.AscendingCmp(s.t, s.slice, compare, s.settings...)
+	} else {
+		test.Match(s.t, s.slice, ordered[A]{}, s.settings...)
+	}
+	return s
+}
+
+// AscendingFunc asserts slice[n] is less than slice[n+1] for each element using
+// the less comparator.
+func (s *SliceValue[A]) AscendingFunc(less func(a, b A) bool) *SliceValue[A] {
+	s.t.Helper()
+	test.AscendingFunc(s.t, s.slice, less, s.settings...)
+	return s
+}
+
+// Descending asserts slice[n] ≥ slice[n+1] for each element.
+//
+// The element type must be an integer, float, or string kind, or implement a
+// Less method. Otherwise use DescendingFunc.
+func (s *SliceValue[A]) Descending() *SliceValue[A] {
+	s.t.Helper()
+	if compare, ok := comparator[A](); ok {
+		test.DescendingCmp(s.t, s.slice, compare, s.settings...)
+	} else {
+		test.Match(s.t, s.slice, ordered[A]{}, s.settings...)
+	}
+	return s
+}
+
+// DescendingFunc asserts slice[n+1] is less than slice[n] for each element using
+// the less comparator.
+func (s *SliceValue[A]) DescendingFunc(less func(a, b A) bool) *SliceValue[A] {
+	s.t.Helper()
+	test.DescendingFunc(s.t, s.slice, less, s.settings...)
+	return s
+}
+
+// comparator returns a compare function for elements of type A, if A is of an
+// ordered kind or implements a Less method.
+func comparator[A any]() (func(a, b A) int, bool) {
+	var zero A
+	if _, ok := any(zero).(interface{ Less(A) bool }); ok {
+		return func(a, b A) int {
+			x, y := any(a).(interface{ Less(A) bool }), any(b).(interface{ Less(A) bool })
+			switch {
+			case x.Less(b):
+				return -1
+			case y.Less(a):
+				return 1
+			default:
+				return 0
+			}
+		}, true
+	}
+
+	switch reflect.TypeFor[A]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b A) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b A) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		}, true
+	case reflect.Float32, reflect.Float64:
+		return func(a, b A) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		}, true
+	case reflect.String:
+		return func(a, b A) int {
+			return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}, true
+	}
+	return nil, false
+}
+
+// ordered is the Matcher of slices with elements of an ordered type, i.e. for
+// which comparator returns a compare function.
+type ordered[A any] struct{}
+
+func (ordered[A]) Match([]A) bool {
+	_, ok := comparator[A]()
+	return ok
+}
+
+func (ordered[A]) Describe() string {
+	return "elements of an ordered type"
+}
+
+func (ordered[A]) DescribeMismatch([]A) string {
+	return fmt.Sprintf("elements of type %s are not ordered; use a Func variant", reflect.TypeFor[A]())
+}
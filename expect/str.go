@@ -0,0 +1,99 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package expect
+
+import (
+	"regexp"
+
+	"github.com/shoenig/test"
+)
+
+// A StrValue is the subject of assertions on a string.
+type StrValue struct {
+	t        T
+	s        string
+	settings []Setting
+}
+
+// Str creates a StrValue for making assertions about s.
+func Str(t T, s string, settings ...Setting) *StrValue {
+	return &StrValue{t: t, s: s, settings: settings}
+}
+
+// Eq asserts the string is equal to exp.
+func (s *StrValue) Eq(exp string) *StrValue {
+	s.t.Helper()
+	test.Eq(s.t, exp, s.s, s.settings...)
+	return s
+}
+
+// EqFold asserts the string is equivalent to exp, ignoring case.
+func (s *StrValue) EqFold(exp string) *StrValue {
+	s.t.Helper()
+	test.StrEqFold(s.t, exp, s.s, s.settings...)
+	return s
+}
+
+// Contains asserts the string contains substring sub.
+func (s *StrValue) Contains(sub string) *StrValue {
+	s.t.Helper()
+	test.StrContains(s.t, s.s, sub, s.settings...)
+	return s
+}
+
+// ContainsFold asserts the string contains substring sub, ignoring case.
+func (s *StrValue) ContainsFold(sub string) *StrValue {
+	s.t.Helper()
+	test.StrContainsFold(s.t, s.s, sub, s.settings...)
+	return s
+}
+
+// NotContains asserts the string does not contain substring sub.
+func (s *StrValue) NotContains(sub string) *StrValue {
+	s.t.Helper()
+	test.StrNotContains(s.t, s.s, sub, s.settings...)
+	return s
+}
+
+// Count asserts the string contains exactly count instances of substring sub.
+func (s *StrValue) Count(sub string, count int) *StrValue {
+	s.t.Helper()
+	test.StrCount(s.t, s.s, sub, count, s.settings...)
+	return s
+}
+
+// HasPrefix asserts the string starts with prefix.
+func (s *StrValue) HasPrefix(prefix string) *StrValue {
+	s.t.Helper()
+	test.StrHasPrefix(s.t, prefix, s.s, s.settings...)
+	return s
+}
+
+// NotHasPrefix asserts the string does not start with prefix.
+func (s *StrValue) NotHasPrefix(prefix string) *StrValue {
+	s.t.Helper()
+	test.StrNotHasPrefix(s.t, prefix, s.s, s.settings...)
+	return s
+}
+
+// HasSuffix asserts the string ends with suffix.
+func (s *StrValue) HasSuffix(suffix string) *StrValue {
+	s.t.Helper()
+	test.StrHasSuffix(s.t, suffix, s.s, s.settings...)
+	return s
+}
+
+// NotHasSuffix asserts the string does not end with suffix.
+func (s *StrValue) NotHasSuffix(suffix string) *StrValue {
+	s.t.Helper()
+	test.StrNotHasSuffix(s.t, suffix, s.s, s.settings...)
+	return s
+}
+
+// Matches asserts the string matches the regular expression re.
+func (s *StrValue) Matches(re *regexp.Regexp) *StrValue {
+	s.t.Helper()
+	test.RegexMatch(s.t, re, s.s, s.settings...)
+	return s
+}
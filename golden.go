@@ -0,0 +1,75 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// UpdateGoldenEnvVar is the environment variable which, when set to a true
+// value, causes golden files and inline snapshots to be rewritten rather than
+// compared against.
+const UpdateGoldenEnvVar = "TEST_UPDATE_GOLDEN"
+
+// goldenFile returns the path of the golden file of the given name for the
+// test case t, i.e. testdata/<TestName>/<name>.golden.
+//
+// If t does not provide its name (unlike testing.T), the golden file is
+// located at testdata/<name>.golden.
+func goldenFile(t T, name string) string {
+	if n, ok := t.(interface{ Name() string }); ok {
+		return filepath.Join("testdata", filepath.FromSlash(n.Name()), name+".golden")
+	}
+	return filepath.Join("testdata", name+".golden")
+}
+
+// update reports whether golden files and inline snapshots are to be rewritten,
+// as indicated by the -update flag or the TEST_UPDATE_GOLDEN environment
+// variable. The -update flag exists only if the test binary defines it, e.g.
+// via flag.Bool("update", false, "update golden files") in a _test.go file.
+func update() bool {
+	if f := flag.Lookup("update"); f != nil {
+		if update, _ := strconv.ParseBool(f.Value.String()); update {
+			return true
+		}
+	}
+	update, _ := strconv.ParseBool(os.Getenv(UpdateGoldenEnvVar))
+	return update
+}
+
+// An InlineSnapshot is the expected content of a value given to Snapshot,
+// written as a string literal within the test source.
+type InlineSnapshot struct {
+	content string
+}
+
+// Inline creates an InlineSnapshot of content. A leading and a trailing newline
+// of content are ignored, so that multi-line snapshots may be written as raw
+// string literals on lines of their own.
+//
+// Example,
+//
+//	Snapshot(t, greeting, Inline(`hello, world!`))
+func Inline(content string) InlineSnapshot {
+	return InlineSnapshot{content: content}
+}
+
+// render returns the snapshot content of value; strings, byte slices, and
+// fmt.Stringer values are used verbatim, other values in Go syntax.
+func render(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
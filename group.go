@@ -0,0 +1,63 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shoenig/test/internal/assertions"
+)
+
+// Group runs f, collecting the failure of each assertion made against g rather
+// than reporting each one as it happens. Once f returns (or panics), any
+// collected failures are reported together as one numbered failure summary.
+//
+// Useful in large e2e style test cases, where many fields of a result are
+// checked at once and reading each failure in isolation is cumbersome.
+//
+// Example,
+//
+//	Group(t, func(g T) {
+//	  Eq(g, "alice", user.Name)
+//	  Eq(g, 42, user.Age)
+//	})
+func Group(t T, f func(g T)) {
+	t.Helper()
+	g := new(group)
+	defer func() {
+		t.Helper()
+		// report collected failures even if f panics, e.g. by dereferencing a
+		// value asserted to be non-nil, before resuming the panic
+		r := recover()
+		if r != nil {
+			g.record("group panicked: %v", r)
+		}
+		if len(g.failures) > 0 {
+			errorf(t, "\n"+assertions.ReportGroup(g.failures...)+"\n")
+		}
+		if r != nil {
+			panic(r)
+		}
+	}()
+	f(g)
+}
+
+// group is the T given to the function of a Group, and records the failure of
+// each assertion instead of reporting it.
+type group struct {
+	failures []*assertions.Failure
+}
+
+func (g *group) Helper() {
+	// nothing
+}
+
+// record a failure reported directly through the T of the group, rather than
+// through an assertion.
+func (g *group) record(msg string, args ...any) {
+	f := &assertions.Failure{Message: strings.TrimSpace(fmt.Sprintf(msg, args...))}
+	f.Locate()
+	g.failures = append(g.failures, f)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// locatorTest is a T recording where a failure is reported, skipping over the
+// functions marked as helpers the way testing.T does.
+type locatorTest struct {
+	helpers  map[string]bool
+	location string
+}
+
+func (lt *locatorTest) Helper() {
+	pc, _, _, _ := runtime.Caller(1)
+	lt.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+func (lt *locatorTest) Errorf(string, ...any) {
+	lt.locate()
+}
+
+func (lt *locatorTest) Fatalf(string, ...any) {
+	lt.locate()
+}
+
+func (lt *locatorTest) locate() {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !lt.helpers[frame.Function] || !more {
+			lt.location = fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+			return
+		}
+	}
+}
+
+func TestGroup(t *testing.T) {
+	tc := newCase(t, `2 assertions failed in group`)
+	t.Cleanup(tc.assert)
+
+	Group(tc, func(g T) {
+		Eq(g, 1, 2)
+		True(g, true)
+		StrContains(g, "abc", "z")
+	})
+}
+
+func TestGroup_summary(t *testing.T) {
+	tc := newCapture(t)
+
+	Group(tc, func(g T) {
+		Eq(g, "one", "two")
+		Nil(g, 42, Sprint("extra"))
+	})
+
+	for _, exp := range []string{
+		"[1] group_test.go:",
+		"expected equality via cmp.Equal function",
+		"[2] group_test.go:",
+		"expected to be nil; is not nil",
+		"↪ PostScript | annotation ↷",
+	} {
+		if !strings.Contains(tc.capture, exp) {
+			t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+		}
+	}
+	if first, second := strings.Index(tc.capture, "[1]"), strings.Index(tc.capture, "[2]"); first > second {
+		t.Fatalf("expected failures in order, got %q", tc.capture)
+	}
+}
+
+func TestGroup_single(t *testing.T) {
+	tc := newCase(t, `1 assertion failed in group`)
+	t.Cleanup(tc.assert)
+
+	Group(tc, func(g T) {
+		False(g, true)
+	})
+}
+
+var panicRe = regexp.MustCompile(`\] group_test\.go:\d+: group panicked`)
+
+func TestGroup_panic(t *testing.T) {
+	tc := newCase(t, `2 assertions failed in group`)
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		for _, exp := range []string{
+			"expected to not be nil; is nil",
+			"group panicked: runtime error: invalid memory address or nil pointer dereference",
+		} {
+			if !strings.Contains(tc.capture, exp) {
+				t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+			}
+		}
+		if !panicRe.MatchString(tc.capture) {
+			t.Fatalf("expected panic located in test code, got %q", tc.capture)
+		}
+	})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic to be resumed")
+		}
+	}()
+
+	Group(tc, func(g T) {
+		var p *Person
+		NotNil(g, p)
+		_ = p.Name
+	})
+}
+
+func TestGroup_location(t *testing.T) {
+	lt := &locatorTest{helpers: make(map[string]bool)}
+
+	_, _, line, _ := runtime.Caller(0)
+	Group(lt, func(g T) { Eq(g, 1, 2) })
+
+	if exp := fmt.Sprintf("group_test.go:%d", line+1); lt.location != exp {
+		t.Fatalf("expected failure reported at %s, got %s", exp, lt.location)
+	}
+}
+
+func TestGroup_pass(t *testing.T) {
+	tc := newCase(t, ``)
+	t.Cleanup(tc.assertNot)
+
+	Group(tc, func(g T) {
+		Eq(g, 1, 1)
+		True(g, true)
+	})
+}
+
+func TestGroup_nested(t *testing.T) {
+	tc := newCase(t, `1 assertion failed in group`)
+	t.Cleanup(tc.assert)
+
+	Group(tc, func(g T) {
+		Group(g, func(inner T) {
+			EqOp(inner, 1, 2)
+			EqOp(inner, 3, 4)
+		})
+	})
+}
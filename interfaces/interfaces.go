@@ -93,3 +93,16 @@ type EmptyFunc interface {
 type ContainsFunc[T any] interface {
 	Contains(T) bool
 }
+
+// The Matcher interface is satisfied by a type that decides whether a value is
+// a match, and describes both what it matches and why a value does not match.
+type Matcher[A any] interface {
+	// Match returns true if value satisfies the Matcher.
+	Match(value A) bool
+
+	// Describe the values satisfying the Matcher, e.g. "is positive".
+	Describe() string
+
+	// DescribeMismatch explains why value does not satisfy the Matcher.
+	DescribeMismatch(value A) string
+}
@@ -9,12 +9,13 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"iter"
 	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
-	"runtime"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/shoenig/test/interfaces"
@@ -22,29 +23,6 @@ import (
 	"github.com/shoenig/test/wait"
 )
 
-const depth = 4
-
-func Caller() string {
-	_, file, line, ok := runtime.Caller(depth)
-	if ok {
-		file = filepath.Base(file)
-		return fmt.Sprintf("%s:%d: ", file, line)
-	}
-	return "[???]"
-}
-
-// diff creates a diff of a and b using cmp.Diff if possible, falling back to printing
-// the Go string values of both types (e.g. contains unexported fields).
-func diff[A, B any](a A, b B, opts cmp.Options) (s string) {
-	defer func() {
-		if r := recover(); r != nil {
-			s = fmt.Sprintf("↪ Assertion | comparison ↷\na: %#v\nb: %#v\n", a, b)
-		}
-	}()
-	s = "↪ Assertion | differential ↷\n" + cmp.Diff(a, b, opts)
-	return
-}
-
 // equal compares a and b using cmp.Equal if possible, falling back to reflect.DeepEqual
 // (e.g. contains unexported fields).
 func equal[A, B any](a A, b B, opts cmp.Options) (result bool) {
@@ -109,424 +87,513 @@ func isNil(a any) bool {
 	}
 }
 
-func Nil(a any) (s string) {
+func Nil(a any) (f *Failure) {
 	if !isNil(a) {
-		s = "expected to be nil; is not nil\n"
+		f = failure("expected to be nil; is not nil\n")
 	}
 	return
 }
 
-func NotNil(a any) (s string) {
+func NotNil(a any) (f *Failure) {
 	if isNil(a) {
-		s = "expected to not be nil; is nil\n"
+		f = failure("expected to not be nil; is nil\n")
 	}
 	return
 }
 
-func True(condition bool) (s string) {
+func True(condition bool) (f *Failure) {
 	if !condition {
-		s = "expected condition to be true; is false\n"
+		f = failure("expected condition to be true; is false\n")
 	}
 	return
 }
 
-func False(condition bool) (s string) {
+func False(condition bool) (f *Failure) {
 	if condition {
-		s = "expected condition to be false; is true\n"
+		f = failure("expected condition to be false; is true\n")
 	}
 	return
 }
 
-func Unreachable() (s string) {
-	s = "expected not to execute this code path\n"
+func Unreachable() (f *Failure) {
+	f = failure("expected not to execute this code path\n")
 	return
 }
 
-func Panic(f func()) (s string) {
+func Panic(fn func()) (f *Failure) {
 	defer func() {
 		if r := recover(); r == nil {
-			s = "expected panic; did not panic"
+			f = failure("expected panic; did not panic")
 		}
 	}()
-	f()
+	fn()
 	return
 }
 
-func NotPanic(f func()) (s string) {
+func NotPanic(fn func()) (f *Failure) {
 	defer func() {
 		if r := recover(); r != nil {
-			s = fmt.Sprintf("expected not to panic; panicked: %v", r)
+			f = failure("expected not to panic; panicked: %v", r)
 		}
 	}()
-	f()
+	fn()
 	return
 }
 
-func Error(err error) (s string) {
+func Error(err error) (f *Failure) {
 	if err == nil {
-		s = "expected non-nil error; got nil\n"
+		f = failure("expected non-nil error; got nil\n")
 	}
 	return
 }
 
-func EqError(err error, msg string) (s string) {
+func EqError(err error, msg string) (f *Failure) {
 	if err == nil {
-		s = "expected non-nil error; got nil\n"
+		f = failure("expected non-nil error; got nil\n")
 		return
 	}
 	e := err.Error()
 	if e != msg {
-		s = "expected matching error strings\n"
-		s += bullet("msg: %q\n", msg)
-		s += bullet("err: %q\n", e)
+		f = failure("expected matching error strings\n")
+		f.bullet("msg: %q\n", msg)
+		f.bullet("err: %q\n", e)
 	}
 	return
 }
 
-func ErrorIs(err error, target error) (s string) {
+func ErrorIs(err error, target error) (f *Failure) {
 	if err == nil && target != nil {
-		s = "expected non-nil error; got nil\n"
+		f = failure("expected non-nil error; got nil\n")
 		return
 	}
 	if !errors.Is(err, target) {
-		s = "expected errors.Is match\n"
-		s += bullet("target: %v\n", target)
-		s += bullet("   got: %v\n", err)
+		f = failure("expected errors.Is match\n")
+		f.bullet("target: %v\n", target)
+		f.bullet("   got: %v\n", err)
 	}
 	return
 }
 
-func ErrorAs[E error, Target any](err error, target Target) (s string) {
+func ErrorAs[E error, Target any](err error, target Target) (f *Failure) {
 	if err == nil {
-		s = "expected non-nil error; got nil\n"
+		f = failure("expected non-nil error; got nil\n")
 		return
 	}
 	if !errors.As(err, target) {
-		s = "expected errors.As match\n"
-		s += bullet("target: %v\n", target)
-		s += bullet("   got: %v\n", err)
+		f = failure("expected errors.As match\n")
+		f.bullet("target: %v\n", target)
+		f.bullet("   got: %v\n", err)
 	}
 	return
 }
 
-func NoError(err error) (s string) {
+func NoError(err error) (f *Failure) {
 	if err != nil {
-		s = "expected nil error\n"
-		s += bullet("error: %v\n", err)
+		f = failure("expected nil error\n")
+		f.bullet("error: %v\n", err)
 	}
 	return
 }
 
-func ErrorContains(err error, sub string) (s string) {
+func ErrorContains(err error, sub string) (f *Failure) {
 	if err == nil {
-		s = "expected non-nil error; got nil\n"
+		f = failure("expected non-nil error; got nil\n")
 		return
 	}
 	actual := err.Error()
 	if !strings.Contains(actual, sub) {
-		s = "expected error to contain substring\n"
-		s += bullet("substring: %s\n", sub)
-		s += bullet("      err: %s\n", actual)
+		f = failure("expected error to contain substring\n")
+		f.bullet("substring: %s\n", sub)
+		f.bullet("      err: %s\n", actual)
 	}
 	return
 }
 
-func Eq[A any](exp, val A, opts ...cmp.Option) (s string) {
+func Eq[A any](exp, val A, opts ...cmp.Option) (f *Failure) {
 	if !equal(exp, val, opts) {
-		s = "expected equality via cmp.Equal function\n"
-		s += diff(exp, val, opts)
+		f = failure("expected equality via cmp.Equal function\n")
+		f.diff(exp, val, opts)
 	}
 	return
 }
 
-func NotEq[A any](exp, val A, opts ...cmp.Option) (s string) {
+func NotEq[A any](exp, val A, opts ...cmp.Option) (f *Failure) {
 	if equal(exp, val, opts) {
-		s = "expected inequality via cmp.Equal function\n"
+		f = failure("expected inequality via cmp.Equal function\n")
 	}
 	return
 }
 
-func EqOp[C comparable](exp, val C) (s string) {
+func EqOp[C comparable](exp, val C) (f *Failure) {
 	if exp != val {
-		s = "expected equality via ==\n"
-		s += diff(exp, val, nil)
+		f = failure("expected equality via ==\n")
+		f.diff(exp, val, nil)
 	}
 	return
 }
 
-func EqFunc[A any](exp, val A, eq func(a, b A) bool) (s string) {
+func EqFunc[A any](exp, val A, eq func(a, b A) bool) (f *Failure) {
 	if !eq(exp, val) {
-		s = "expected equality via 'eq' function\n"
-		s += diff(exp, val, nil)
+		f = failure("expected equality via 'eq' function\n")
+		f.diff(exp, val, nil)
 	}
 	return
 }
 
-func NotEqOp[C comparable](exp, val C) (s string) {
+func NotEqOp[C comparable](exp, val C) (f *Failure) {
 	if exp == val {
-		s = "expected inequality via !=\n"
+		f = failure("expected inequality via !=\n")
 	}
 	return
 }
 
-func NotEqFunc[A any](exp, val A, eq func(a, b A) bool) (s string) {
+func NotEqFunc[A any](exp, val A, eq func(a, b A) bool) (f *Failure) {
 	if eq(exp, val) {
-		s = "expected inequality via 'eq' function\n"
+		f = failure("expected inequality via 'eq' function\n")
 	}
 	return
 }
 
-func EqJSON(exp, val string) (s string) {
+func EqJSON(exp, val string) (f *Failure) {
 	var expA, expB any
 
 	if err := json.Unmarshal([]byte(exp), &expA); err != nil {
-		s = fmt.Sprintf("failed to unmarshal first argument as JSON: %v\n", err)
+		f = failure("failed to unmarshal first argument as JSON: %v\n", err)
 		return
 	}
 
 	if err := json.Unmarshal([]byte(val), &expB); err != nil {
-		s = fmt.Sprintf("failed to unmarshal second argument as JSON: %v\n", err)
+		f = failure("failed to unmarshal second argument as JSON: %v\n", err)
 		return
 	}
 
 	if !reflect.DeepEqual(expA, expB) {
-		jsonA, _ := json.Marshal(expA)
-		jsonB, _ := json.Marshal(expB)
-		s = "expected equality via JSON marshalling\n"
-		s += diff(string(jsonA), string(jsonB), nil)
+		jsonA, _ := json.MarshalIndent(expA, "", "  ")
+		jsonB, _ := json.MarshalIndent(expB, "", "  ")
+		f = failure("expected equality via JSON marshalling\n")
+		f.diff(string(jsonA), string(jsonB), nil)
 		return
 	}
 
 	return
 }
 
-func ValidJSON(input string) (s string) {
+func ValidJSON(input string) (f *Failure) {
 	return validJSON([]byte(input))
 }
 
-func ValidJSONBytes(input []byte) (s string) {
+func ValidJSONBytes(input []byte) (f *Failure) {
 	return validJSON(input)
 }
 
-func validJSON(input []byte) (s string) {
+func validJSON(input []byte) (f *Failure) {
 	if !json.Valid([]byte(input)) {
-		return "expected input to be valid JSON\n"
+		return failure("expected input to be valid JSON\n")
 	}
 	return
 }
 
-func EqSliceFunc[A, B any](exp []B, val []A, eq func(a A, b B) bool) (s string) {
+func EqSliceFunc[A, B any](exp []B, val []A, eq func(a A, b B) bool) (f *Failure) {
 	lenA, lenB := len(exp), len(val)
 
+	match := func(e B, v A) bool {
+		return eq(v, e)
+	}
+
 	if lenA != lenB {
-		s = "expected slices of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		s += diff(exp, val, nil)
+		f = failure("expected slices of same length\n")
+		f.bullet("len(exp): %d\n", lenA)
+		f.bullet("len(val): %d\n", lenB)
+		elements(f, exp, val, match)
 		return
 	}
 
-	miss := false
 	for i := 0; i < lenA; i++ {
 		if !eq(val[i], exp[i]) {
-			miss = true
-			break
+			f = failure("expected slice equality via 'eq' function\n")
+			elements(f, exp, val, match)
+			return
 		}
 	}
 
-	if miss {
-		s = "expected slice equality via 'eq' function\n"
-		s += diff(exp, val, nil)
-		return
-	}
-
 	return
 }
 
-func Equal[E interfaces.EqualFunc[E]](exp, val E) (s string) {
+func Equal[E interfaces.EqualFunc[E]](exp, val E) (f *Failure) {
 	if !val.Equal(exp) {
-		s = "expected equality via .Equal method\n"
-		s += diff(exp, val, nil)
+		f = failure("expected equality via .Equal method\n")
+		f.diff(exp, val, nil)
 	}
 	return
 }
 
-func NotEqual[E interfaces.EqualFunc[E]](exp, val E) (s string) {
+func NotEqual[E interfaces.EqualFunc[E]](exp, val E) (f *Failure) {
 	if val.Equal(exp) {
-		s = "expected inequality via .Equal method\n"
+		f = failure("expected inequality via .Equal method\n")
 	}
 	return
 }
 
-func SliceEqual[E interfaces.EqualFunc[E]](exp, val []E) (s string) {
+func SliceEqual[E interfaces.EqualFunc[E]](exp, val []E) (f *Failure) {
 	lenA, lenB := len(exp), len(val)
 
+	match := func(e, v E) bool {
+		return e.Equal(v)
+	}
+
 	if lenA != lenB {
-		s = "expected slices of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		s += diff(exp, val, nil)
+		f = failure("expected slices of same length\n")
+		f.bullet("len(exp): %d\n", lenA)
+		f.bullet("len(val): %d\n", lenB)
+		elements(f, exp, val, match)
 		return
 	}
 
 	for i := 0; i < lenA; i++ {
-		if !exp[i].Equal(val[i]) {
-			s += "expected slice equality via .Equal method\n"
-			s += diff(exp[i], val[i], nil)
+		if !match(exp[i], val[i]) {
+			f = failure("expected slice equality via .Equal method\n")
+			elements(f, exp, val, match)
 			return
 		}
 	}
 	return
 }
 
-func SliceEqOp[A comparable, S ~[]A](exp, val S) (s string) {
+func SliceEqOp[A comparable, S ~[]A](exp, val S) (f *Failure) {
 	lenA, lenB := len(exp), len(val)
 
 	if lenA != lenB {
-		s = "expected slices of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		s += diff(exp, val, nil)
+		f = failure("expected slices of same length\n")
+		f.bullet("len(exp): %d\n", lenA)
+		f.bullet("len(val): %d\n", lenB)
+		f.diff(exp, val, nil)
 		return
 	}
 
 	for i := 0; i < lenA; i++ {
 		if exp[i] != val[i] {
-			s += "expected slice equality via ==\n"
-			s += diff(exp[i], val[i], nil)
+			f = failure("expected slice equality via ==\n")
+			f.diff(exp[i], val[i], nil)
 			return
 		}
 	}
 	return
 }
 
-func Lesser[L interfaces.LessFunc[L]](exp, val L) (s string) {
+// A tally counts the occurrences of equivalent elements in exp and val.
+type tally[A any] struct {
+	item A
+	exp  int
+	val  int
+}
+
+// count the occurrences of each distinct element of exp and val, where find
+// returns the index of the tally of an element (or -1 if not yet counted).
+func count[A any](exp, val []A, find func([]tally[A], A) int) []tally[A] {
+	var tallies []tally[A]
+	for i, items := range [][]A{exp, val} {
+		for _, item := range items {
+			idx := find(tallies, item)
+			if idx < 0 {
+				tallies = append(tallies, tally[A]{item: item})
+				idx = len(tallies) - 1
+			}
+			if i == 0 {
+				tallies[idx].exp++
+			} else {
+				tallies[idx].val++
+			}
+		}
+	}
+	return tallies
+}
+
+func unordered[A any](exp, val []A, method string, find func([]tally[A], A) int) (f *Failure) {
+	for _, t := range count(exp, val, find) {
+		if t.exp == t.val {
+			continue
+		}
+		if f == nil {
+			f = failure("expected slices of same elements in any order via %s\n", method)
+		}
+		switch {
+		case t.val == 0:
+			f.bullet("missing: %#v%s\n", t.item, times(t.exp))
+		case t.exp == 0:
+			f.bullet("  extra: %#v%s\n", t.item, times(t.val))
+		default:
+			f.bullet("  count: %#v exp %d, val %d\n", t.item, t.exp, t.val)
+		}
+	}
+	return
+}
+
+func times(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return fmt.Sprintf(" (×%d)", n)
+}
+
+func findFunc[A any](eq func(a, b A) bool) func([]tally[A], A) int {
+	return func(tallies []tally[A], item A) int {
+		for i, t := range tallies {
+			if eq(t.item, item) {
+				return i
+			}
+		}
+		return -1
+	}
+}
+
+func SliceEqUnordered[A any](exp, val []A, opts cmp.Options) (f *Failure) {
+	return unordered(exp, val, "cmp.Equal function", findFunc(func(a, b A) bool {
+		return equal(a, b, opts)
+	}))
+}
+
+func SliceEqUnorderedFunc[A any](exp, val []A, eq func(a, b A) bool) (f *Failure) {
+	return unordered(exp, val, "'eq' function", findFunc(eq))
+}
+
+func SliceEqUnorderedOp[C comparable](exp, val []C) (f *Failure) {
+	index := make(map[C]int)
+	return unordered(exp, val, "== operator", func(tallies []tally[C], item C) int {
+		if i, exists := index[item]; exists {
+			return i
+		}
+		index[item] = len(tallies)
+		return -1
+	})
+}
+
+func Lesser[L interfaces.LessFunc[L]](exp, val L) (f *Failure) {
 	if !val.Less(exp) {
-		s = "expected val to be less via .Less method\n"
-		s += diff(exp, val, nil)
+		f = failure("expected val to be less via .Less method\n")
+		f.diff(exp, val, nil)
 	}
 	return
 }
 
-func SliceEmpty[A any](slice []A) (s string) {
+func SliceEmpty[A any](slice []A) (f *Failure) {
 	if len(slice) != 0 {
-		s = "expected slice to be empty\n"
-		s += bullet("len(slice): %d\n", len(slice))
+		f = failure("expected slice to be empty\n")
+		f.bullet("len(slice): %d\n", len(slice))
 	}
 	return
 }
 
-func SliceNotEmpty[A any](slice []A) (s string) {
+func SliceNotEmpty[A any](slice []A) (f *Failure) {
 	if len(slice) == 0 {
-		s = "expected slice to not be empty\n"
-		s += bullet("len(slice): %d\n", len(slice))
+		f = failure("expected slice to not be empty\n")
+		f.bullet("len(slice): %d\n", len(slice))
 	}
 	return
 }
 
-func SliceLen[A any](n int, slice []A) (s string) {
+func SliceLen[A any](n int, slice []A) (f *Failure) {
 	if l := len(slice); l != n {
-		s = "expected slice to be different length\n"
-		s += bullet("len(slice): %d, expected: %d\n", l, n)
+		f = failure("expected slice to be different length\n")
+		f.bullet("len(slice): %d, expected: %d\n", l, n)
 	}
 	return
 }
 
-func SliceContainsOp[C comparable](slice []C, item C) (s string) {
+func SliceContainsOp[C comparable](slice []C, item C) (f *Failure) {
 	if !contains(slice, item) {
-		s = "expected slice to contain missing item via == operator\n"
-		s += bullet("slice is missing %#v\n", item)
+		f = failure("expected slice to contain missing item via == operator\n")
+		f.bullet("slice is missing %#v\n", item)
 	}
 	return
 }
 
-func SliceContainsFunc[A, B any](slice []A, item B, eq func(a A, b B) bool) (s string) {
+func SliceContainsFunc[A, B any](slice []A, item B, eq func(a A, b B) bool) (f *Failure) {
 	if !containsFunc(slice, item, eq) {
-		s = "expected slice to contain missing item via 'eq' function\n"
-		s += bullet("slice is missing %#v\n", item)
+		f = failure("expected slice to contain missing item via 'eq' function\n")
+		f.bullet("slice is missing %#v\n", item)
 	}
 	return
 }
 
-func SliceContainsEqual[E interfaces.EqualFunc[E]](slice []E, item E) (s string) {
+func SliceContainsEqual[E interfaces.EqualFunc[E]](slice []E, item E) (f *Failure) {
 	if !containsFunc(slice, item, E.Equal) {
-		s = "expected slice to contain missing item via .Equal method\n"
-		s += bullet("slice is missing %#v\n", item)
+		f = failure("expected slice to contain missing item via .Equal method\n")
+		f.bullet("slice is missing %#v\n", item)
 	}
 	return
 }
 
-func SliceContains[A any](slice []A, item A, opts ...cmp.Option) (s string) {
+func SliceContains[A any](slice []A, item A, opts ...cmp.Option) (f *Failure) {
 	for _, i := range slice {
 		if cmp.Equal(i, item, opts...) {
 			return
 		}
 	}
-	s = "expected slice to contain missing item via cmp.Equal method\n"
-	s += bullet("slice is missing %#v\n", item)
+	f = failure("expected slice to contain missing item via cmp.Equal method\n")
+	f.bullet("slice is missing %#v\n", item)
 	return
 }
 
-func SliceNotContains[A any](slice []A, item A, opts ...cmp.Option) (s string) {
+func SliceNotContains[A any](slice []A, item A, opts ...cmp.Option) (f *Failure) {
 	for _, i := range slice {
 		if cmp.Equal(i, item, opts...) {
-			s = "expected slice to not contain item but it does\n"
-			s += bullet("unwanted item %#v\n", item)
+			f = failure("expected slice to not contain item but it does\n")
+			f.bullet("unwanted item %#v\n", item)
 			return
 		}
 	}
 	return
 }
 
-func SliceNotContainsFunc[A, B any](slice []A, item B, eq func(a A, b B) bool) (s string) {
+func SliceNotContainsFunc[A, B any](slice []A, item B, eq func(a A, b B) bool) (f *Failure) {
 	if containsFunc(slice, item, eq) {
-		s = "expected slice to not contain item but it does\n"
-		s += bullet("unwanted item %#v\n", item)
+		f = failure("expected slice to not contain item but it does\n")
+		f.bullet("unwanted item %#v\n", item)
 	}
 	return
 }
 
-func SliceContainsAllOp[C comparable](slice, items []C) (s string) {
+func SliceContainsAllOp[C comparable](slice, items []C) (f *Failure) {
 	if len(slice) != len(items) {
-		s = "expected slice and items to contain same number of elements\n"
-		s += bullet("len(slice): %d\n", len(slice))
-		s += bullet("len(items): %d\n", len(items))
-		return s
+		f = failure("expected slice and items to contain same number of elements\n")
+		f.bullet("len(slice): %d\n", len(slice))
+		f.bullet("len(items): %d\n", len(items))
+		return f
 	}
 	return SliceContainsSubsetOp(slice, items)
 }
 
-func SliceContainsAllFunc[A, B any](slice []A, items []B, eq func(a A, b B) bool) (s string) {
+func SliceContainsAllFunc[A, B any](slice []A, items []B, eq func(a A, b B) bool) (f *Failure) {
 	if len(slice) != len(items) {
-		s = "expected slice and items to contain same number of elements\n"
-		s += bullet("len(slice): %d\n", len(slice))
-		s += bullet("len(items): %d\n", len(items))
-		return s
+		f = failure("expected slice and items to contain same number of elements\n")
+		f.bullet("len(slice): %d\n", len(slice))
+		f.bullet("len(items): %d\n", len(items))
+		return f
 	}
 	return SliceContainsSubsetFunc(slice, items, eq)
 }
 
-func SliceContainsAllEqual[E interfaces.EqualFunc[E]](slice, items []E) (s string) {
+func SliceContainsAllEqual[E interfaces.EqualFunc[E]](slice, items []E) (f *Failure) {
 	if len(slice) != len(items) {
-		s = "expected slice and items to contain same number of elements\n"
-		s += bullet("len(slice): %d\n", len(slice))
-		s += bullet("len(items): %d\n", len(items))
-		return s
+		f = failure("expected slice and items to contain same number of elements\n")
+		f.bullet("len(slice): %d\n", len(slice))
+		f.bullet("len(items): %d\n", len(items))
+		return f
 	}
 	return SliceContainsSubsetEqual(slice, items)
 }
 
-func SliceContainsAll[A any](slice, items []A, opts ...cmp.Option) (s string) {
+func SliceContainsAll[A any](slice, items []A, opts ...cmp.Option) (f *Failure) {
 	if len(slice) != len(items) {
-		s = "expected slice and items to contain same number of elements\n"
-		s += bullet("len(slice): %d\n", len(slice))
-		s += bullet("len(items): %d\n", len(items))
-		return s
+		f = failure("expected slice and items to contain same number of elements\n")
+		f.bullet("len(slice): %d\n", len(slice))
+		f.bullet("len(items): %d\n", len(items))
+		return f
 	}
 	return SliceContainsSubset(slice, items, opts...)
 }
 
-func SliceContainsSubsetOp[C comparable](slice, items []C) (s string) {
+func SliceContainsSubsetOp[C comparable](slice, items []C) (f *Failure) {
 OUTER:
 	for _, target := range items {
 		var item C
@@ -535,32 +602,32 @@ OUTER:
 				continue OUTER
 			}
 		}
-		s = "expected slice to contain missing item via == operator\n"
-		s += bullet("slice is missing %#v\n", target)
+		f = failure("expected slice to contain missing item via == operator\n")
+		f.bullet("slice is missing %#v\n", target)
 		return
 	}
 	return
 }
 
-func SliceContainsSubsetFunc[A, B any](slice []A, items []B, eq func(a A, b B) bool) (s string) {
+func SliceContainsSubsetFunc[A, B any](slice []A, items []B, eq func(a A, b B) bool) (f *Failure) {
 	ok, missing := containsSubsetFunc(slice, items, eq)
 	if !ok {
-		s = "expected slice to contain missing item via 'eq' function\n"
-		s += bullet("slice is missing %#v\n", missing)
+		f = failure("expected slice to contain missing item via 'eq' function\n")
+		f.bullet("slice is missing %#v\n", missing)
 	}
 	return
 }
 
-func SliceContainsSubsetEqual[E interfaces.EqualFunc[E]](slice, items []E) (s string) {
+func SliceContainsSubsetEqual[E interfaces.EqualFunc[E]](slice, items []E) (f *Failure) {
 	ok, missing := containsSubsetFunc(slice, items, E.Equal)
 	if !ok {
-		s = "expected slice to contain missing item via .Equal method\n"
-		s += bullet("slice is missing %#v\n", missing)
+		f = failure("expected slice to contain missing item via .Equal method\n")
+		f.bullet("slice is missing %#v\n", missing)
 	}
 	return
 }
 
-func SliceContainsSubset[A any](slice, items []A, opts ...cmp.Option) (s string) {
+func SliceContainsSubset[A any](slice, items []A, opts ...cmp.Option) (f *Failure) {
 OUTER:
 	for _, target := range items {
 		var item A
@@ -569,464 +636,539 @@ OUTER:
 				continue OUTER
 			}
 		}
-		s = "expected slice to contain missing item\n"
-		s += bullet("slice is missing %#v\n", target)
+		f = failure("expected slice to contain missing item\n")
+		f.bullet("slice is missing %#v\n", target)
 		return
 	}
 	return
 }
 
-func Positive[N interfaces.Number](value N) (s string) {
+// A Pair is an element of an iter.Seq2.
+type Pair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// GoString renders p as its key and value, e.g. in the difference of sequences.
+func (p Pair[K, V]) GoString() string {
+	return fmt.Sprintf("%#v: %#v", p.Key, p.Value)
+}
+
+// collect the elements of seq, up to max elements.
+func collect[A any](seq iter.Seq[A], max int) (elements []A, f *Failure) {
+	for element := range seq {
+		if len(elements) == max {
+			return nil, exhausted(max)
+		}
+		elements = append(elements, element)
+	}
+	return elements, nil
+}
+
+// collect2 the pairs of seq, up to max pairs.
+func collect2[K, V any](seq iter.Seq2[K, V], max int) (pairs []Pair[K, V], f *Failure) {
+	for key, value := range seq {
+		if len(pairs) == max {
+			return nil, exhausted(max)
+		}
+		pairs = append(pairs, Pair[K, V]{Key: key, Value: value})
+	}
+	return pairs, nil
+}
+
+func exhausted(max int) (f *Failure) {
+	f = failure("expected sequence to end within %d items\n", max)
+	f.bullet("hint: use MaxItems to consume more items\n")
+	return
+}
+
+func SeqEq[A any](exp []A, seq iter.Seq[A], max int, opts ...cmp.Option) (f *Failure) {
+	val, f := collect(seq, max)
+	if f != nil {
+		return
+	}
+	return seqEq(exp, val, opts)
+}
+
+func Seq2Eq[K, V any](exp, seq iter.Seq2[K, V], max int, opts ...cmp.Option) (f *Failure) {
+	expPairs, f := collect2(exp, max)
+	if f != nil {
+		return
+	}
+	valPairs, f := collect2(seq, max)
+	if f != nil {
+		return
+	}
+	return seqEq(expPairs, valPairs, opts)
+}
+
+// seqEq compares the elements collected from sequences, recording their
+// element-wise difference as for slices.
+func seqEq[A any](exp, val []A, opts cmp.Options) (f *Failure) {
+	match := func(a, b A) bool {
+		return equal(a, b, opts)
+	}
+	if len(exp) != len(val) {
+		f = failure("expected sequence of same length\n")
+		f.bullet("len(exp): %d\n", len(exp))
+		f.bullet("len(seq): %d\n", len(val))
+		elements(f, exp, val, match)
+		return
+	}
+	if !equal(exp, val, opts) {
+		f = failure("expected sequence equality via cmp.Equal function\n")
+		elements(f, exp, val, match)
+	}
+	return
+}
+
+func SeqLen[A any](n int, seq iter.Seq[A], max int) (f *Failure) {
+	val, f := collect(seq, max)
+	if f != nil {
+		return
+	}
+	if l := len(val); l != n {
+		f = failure("expected sequence to be different length\n")
+		f.bullet("len(seq): %d, expected: %d\n", l, n)
+	}
+	return
+}
+
+func SeqEmpty[A any](seq iter.Seq[A]) (f *Failure) {
+	for element := range seq {
+		f = failure("expected sequence to be empty\n")
+		f.bullet("first item: %#v\n", element)
+		return
+	}
+	return
+}
+
+func SeqContains[A any](seq iter.Seq[A], item A, max int, opts ...cmp.Option) (f *Failure) {
+	n := 0
+	for element := range seq {
+		if n == max {
+			return exhausted(max)
+		}
+		if cmp.Equal(element, item, opts...) {
+			return
+		}
+		n++
+	}
+	f = failure("expected sequence to contain missing item via cmp.Equal method\n")
+	f.bullet("sequence is missing %#v\n", item)
+	return
+}
+
+func SeqContainsAll[A any](seq iter.Seq[A], items []A, max int, opts ...cmp.Option) (f *Failure) {
+	val, f := collect(seq, max)
+	if f != nil {
+		return
+	}
+	if len(val) != len(items) {
+		f = failure("expected sequence and items to contain same number of elements\n")
+		f.bullet("  len(seq): %d\n", len(val))
+		f.bullet("len(items): %d\n", len(items))
+		return
+	}
+OUTER:
+	for _, target := range items {
+		for _, element := range val {
+			if cmp.Equal(target, element, opts...) {
+				continue OUTER
+			}
+		}
+		f = failure("expected sequence to contain missing item\n")
+		f.bullet("sequence is missing %#v\n", target)
+		return
+	}
+	return
+}
+
+func SeqAscending[O constraints.Ordered](seq iter.Seq[O], max int) (f *Failure) {
+	var previous O
+	i := 0
+	for element := range seq {
+		if i == max {
+			return exhausted(max)
+		}
+		if i > 0 && previous > element {
+			f = failure("expected item[%d] <= item[%d]\n", i-1, i)
+			f.bullet("item[%d]: %v\n", i-1, previous)
+			f.bullet("item[%d]: %v\n", i, element)
+			return
+		}
+		previous = element
+		i++
+	}
+	return
+}
+
+func Positive[N interfaces.Number](value N) (f *Failure) {
 	if !(value > 0) {
-		s = "expected positive value\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected positive value\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func NonPositive[N interfaces.Number](value N) (s string) {
+func NonPositive[N interfaces.Number](value N) (f *Failure) {
 	if !(value <= 0) {
-		s = "expected non-positive value\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected non-positive value\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func Negative[N interfaces.Number](value N) (s string) {
+func Negative[N interfaces.Number](value N) (f *Failure) {
 	if value > 0 {
-		s = "expected negative value\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected negative value\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func NonNegative[N interfaces.Number](value N) (s string) {
+func NonNegative[N interfaces.Number](value N) (f *Failure) {
 	if !(value >= 0) {
-		s = "expected non-negative value\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected non-negative value\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func Zero[N interfaces.Number](value N) (s string) {
+func Zero[N interfaces.Number](value N) (f *Failure) {
 	if value != 0 {
-		s = "expected value of 0\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected value of 0\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func NonZero[N interfaces.Number](value N) (s string) {
+func NonZero[N interfaces.Number](value N) (f *Failure) {
 	if value == 0 {
-		s = "expected non-zero value\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected non-zero value\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func ZeroValue[T any](v T, opts ...cmp.Option) (s string) {
+func ZeroValue[T any](v T, opts ...cmp.Option) (f *Failure) {
 	var zero T
 	z, ok := any(v).(interface{ IsZero() bool })
 	if ok {
 		if !z.IsZero() {
-			s = "expected zero via IsZero method\n"
-			s += diff(zero, v, opts)
+			f = failure("expected zero via IsZero method\n")
+			f.diff(zero, v, opts)
 		}
 		return
 	}
 
 	if !equal(zero, v, opts) {
-		s = "expected zero via cmp.Equal function\n"
-		s += diff(zero, v, opts)
+		f = failure("expected zero via cmp.Equal function\n")
+		f.diff(zero, v, opts)
 	}
 	return
 }
 
-func NotZeroValue[T any](v T, opts ...cmp.Option) (s string) {
+func NotZeroValue[T any](v T, opts ...cmp.Option) (f *Failure) {
 	var zero T
 	z, ok := any(v).(interface{ IsZero() bool })
 	if ok {
 		if z.IsZero() {
-			s = "expected non-zero via IsZero method\n"
+			f = failure("expected non-zero via IsZero method\n")
 		}
 		return
 	}
 
 	if equal(zero, v, opts) {
-		s = "expected non-zero via cmp.Equal function\n"
+		f = failure("expected non-zero via cmp.Equal function\n")
 	}
 	return
 }
 
-func One[N interfaces.Number](value N) (s string) {
+func One[N interfaces.Number](value N) (f *Failure) {
 	if value != 1 {
-		s = "expected value of 1\n"
-		s += bullet("value: %v\n", value)
+		f = failure("expected value of 1\n")
+		f.bullet("value: %v\n", value)
 	}
 	return
 }
 
-func Less[O constraints.Ordered](exp, val O) (s string) {
+func Less[O constraints.Ordered](exp, val O) (f *Failure) {
 	if !(val < exp) {
-		s = fmt.Sprintf("expected %v < %v\n", val, exp)
+		f = failure("expected %v < %v\n", val, exp)
 	}
 	return
 }
 
-func LessEq[O constraints.Ordered](exp, val O) (s string) {
+func LessEq[O constraints.Ordered](exp, val O) (f *Failure) {
 	if !(val <= exp) {
-		s = fmt.Sprintf("expected %v ≤ %v\n", val, exp)
+		f = failure("expected %v ≤ %v\n", val, exp)
 	}
 	return
 }
 
-func Greater[O constraints.Ordered](exp, val O) (s string) {
+func Greater[O constraints.Ordered](exp, val O) (f *Failure) {
 	if !(val > exp) {
-		s = fmt.Sprintf("expected %v > %v\n", val, exp)
+		f = failure("expected %v > %v\n", val, exp)
 	}
 	return
 }
 
-func GreaterEq[O constraints.Ordered](exp, val O) (s string) {
+func GreaterEq[O constraints.Ordered](exp, val O) (f *Failure) {
 	if !(val >= exp) {
-		s = fmt.Sprintf("expected %v ≥ %v\n", val, exp)
+		f = failure("expected %v ≥ %v\n", val, exp)
 	}
 	return
 }
 
-func Between[O constraints.Ordered](lower, val, upper O) (s string) {
+func Between[O constraints.Ordered](lower, val, upper O) (f *Failure) {
 	if val < lower || val > upper {
-		s = fmt.Sprintf("expected val in range (%v ≤ val ≤ %v)\n", lower, upper)
-		s += bullet("val: %v\n", val)
+		f = failure("expected val in range (%v ≤ val ≤ %v)\n", lower, upper)
+		f.bullet("val: %v\n", val)
 		return
 	}
 	return
 }
 
-func BetweenExclusive[O constraints.Ordered](lower, val, upper O) (s string) {
+func BetweenExclusive[O constraints.Ordered](lower, val, upper O) (f *Failure) {
 	if val <= lower || val >= upper {
-		s = fmt.Sprintf("expected val in range (%v < val < %v)\n", lower, upper)
-		s += bullet("val: %v\n", val)
+		f = failure("expected val in range (%v < val < %v)\n", lower, upper)
+		f.bullet("val: %v\n", val)
 		return
 	}
 	return
 }
 
-func Min[A any, C interfaces.MinFunc[A]](expect A, collection C, opts ...cmp.Option) (s string) {
+func Min[A any, C interfaces.MinFunc[A]](expect A, collection C, opts ...cmp.Option) (f *Failure) {
 	min := collection.Min()
 	if !equal(expect, min, opts) {
-		s = "expected a different value for min\n"
-		s += diff(expect, min, opts)
+		f = failure("expected a different value for min\n")
+		f.diff(expect, min, opts)
 	}
 	return
 }
 
-func Max[A any, C interfaces.MaxFunc[A]](expect A, collection C, opts ...cmp.Option) (s string) {
+func Max[A any, C interfaces.MaxFunc[A]](expect A, collection C, opts ...cmp.Option) (f *Failure) {
 	max := collection.Max()
 	if !equal(expect, max, opts) {
-		s = "expected a different value for max\n"
-		s += diff(expect, max, opts)
+		f = failure("expected a different value for max\n")
+		f.diff(expect, max, opts)
 	}
 	return
 }
 
-func Ascending[O constraints.Ordered](slice []O) (s string) {
+func Ascending[O constraints.Ordered](slice []O) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		if slice[i] > slice[i+1] {
-			s = fmt.Sprintf("expected slice[%d] <= slice[%d]\n", i, i+1)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected slice[%d] <= slice[%d]\n", i, i+1)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func AscendingFunc[A any](slice []A, less func(a, b A) bool) (s string) {
+func AscendingFunc[A any](slice []A, less func(a, b A) bool) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		if !less(slice[i], slice[i+1]) {
-			s = fmt.Sprintf("expected less(slice[%d], slice[%d])\n", i, i+1)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected less(slice[%d], slice[%d])\n", i, i+1)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func AscendingCmp[A any](slice []A, compare func(a, b A) int) (s string) {
+func AscendingCmp[A any](slice []A, compare func(a, b A) int) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		cmp := compare(slice[i], slice[i+1])
 		if cmp > 0 {
-			s = fmt.Sprintf("expected compare(slice[%d], slice[%d]) <= 0\n", i, i+1)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected compare(slice[%d], slice[%d]) <= 0\n", i, i+1)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func AscendingLess[L interfaces.LessFunc[L]](slice []L) (s string) {
+func AscendingLess[L interfaces.LessFunc[L]](slice []L) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		if !slice[i].Less(slice[i+1]) {
-			s = fmt.Sprintf("expected slice[%d].Less(slice[%d])\n", i, i+1)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected slice[%d].Less(slice[%d])\n", i, i+1)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func Descending[O constraints.Ordered](slice []O) (s string) {
+func Descending[O constraints.Ordered](slice []O) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		if slice[i] < slice[i+1] {
-			s = fmt.Sprintf("expected slice[%d] >= slice[%d]\n", i, i+1)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected slice[%d] >= slice[%d]\n", i, i+1)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func DescendingFunc[A any](slice []A, less func(a, b A) bool) (s string) {
+func DescendingFunc[A any](slice []A, less func(a, b A) bool) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		if !less(slice[i+1], slice[i]) {
-			s = fmt.Sprintf("expected less(slice[%d], slice[%d])\n", i+1, i)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected less(slice[%d], slice[%d])\n", i+1, i)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func DescendingCmp[A any](slice []A, compare func(a, b A) int) (s string) {
+func DescendingCmp[A any](slice []A, compare func(a, b A) int) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		cmp := compare(slice[i], slice[i+1])
 		if cmp < 0 {
-			s = fmt.Sprintf("expected compare(slice[%d], slice[%d]) >= 0\n", i, i+1)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected compare(slice[%d], slice[%d]) >= 0\n", i, i+1)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func DescendingLess[L interfaces.LessFunc[L]](slice []L) (s string) {
+func DescendingLess[L interfaces.LessFunc[L]](slice []L) (f *Failure) {
 	for i := 0; i < len(slice)-1; i++ {
 		if !(slice[i+1].Less(slice[i])) {
-			s = fmt.Sprintf("expected slice[%d].Less(slice[%d])\n", i+1, i)
-			s += bullet("slice[%d]: %v\n", i, slice[i])
-			s += bullet("slice[%d]: %v\n", i+1, slice[i+1])
+			f = failure("expected slice[%d].Less(slice[%d])\n", i+1, i)
+			f.bullet("slice[%d]: %v\n", i, slice[i])
+			f.bullet("slice[%d]: %v\n", i+1, slice[i+1])
 			return
 		}
 	}
 	return
 }
 
-func InDelta[N interfaces.Number](a, b, delta N) (s string) {
+func InDelta[N interfaces.Number](a, b, delta N) (f *Failure) {
 	var zero N
 
 	if !interfaces.Numeric(delta) {
-		s = fmt.Sprintf("delta must be numeric; got %v\n", delta)
+		f = failure("delta must be numeric; got %v\n", delta)
 		return
 	}
 
 	if delta <= zero {
-		s = fmt.Sprintf("delta must be positive; got %v\n", delta)
+		f = failure("delta must be positive; got %v\n", delta)
 		return
 	}
 
 	if !interfaces.Numeric(a) {
-		s = fmt.Sprintf("first argument must be numeric; got %v\n", a)
+		f = failure("first argument must be numeric; got %v\n", a)
 		return
 	}
 
 	if !interfaces.Numeric(b) {
-		s = fmt.Sprintf("second argument must be numeric; got %v\n", b)
+		f = failure("second argument must be numeric; got %v\n", b)
 		return
 	}
 
 	difference := a - b
 	if difference < -delta || difference > delta {
-		s = fmt.Sprintf("%v and %v not within %v\n", a, b, delta)
+		f = failure("%v and %v not within %v\n", a, b, delta)
 		return
 	}
 
 	return
 }
 
-func InDeltaSlice[N interfaces.Number](a, b []N, delta N) (s string) {
+func InDeltaSlice[N interfaces.Number](a, b []N, delta N) (f *Failure) {
 	if len(a) != len(b) {
-		s = "expected slices of same length\n"
-		s += bullet("len(slice a): %d\n", len(a))
-		s += bullet("len(slice b): %d\n", len(b))
+		f = failure("expected slices of same length\n")
+		f.bullet("len(slice a): %d\n", len(a))
+		f.bullet("len(slice b): %d\n", len(b))
 		return
 	}
 
 	for i := 0; i < len(a); i++ {
-		if s = InDelta(a[i], b[i], delta); s != "" {
+		if f = InDelta(a[i], b[i], delta); f != nil {
 			return
 		}
 	}
 	return
 }
 
-func MapEq[M1, M2 interfaces.Map[K, V], K comparable, V any](exp M1, val M2, opts cmp.Options) (s string) {
-	lenA, lenB := len(exp), len(val)
-
-	if lenA != lenB {
-		s = "expected maps of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		return
-	}
-
-	for key, valA := range exp {
-		valB, exists := val[key]
-		if !exists {
-			s = "expected maps of same keys\n"
-			s += diff(exp, val, opts)
-			return
-		}
-
-		if !cmp.Equal(valA, valB, opts) {
-			s = "expected maps of same values via cmp.Equal function\n"
-			s += diff(exp, val, opts)
-			return
-		}
+func MapEq[M1, M2 interfaces.Map[K, V], K comparable, V any](exp M1, val M2, opts cmp.Options, limit int) (f *Failure) {
+	eq := func(a, b V) bool {
+		return cmp.Equal(a, b, opts)
 	}
-	return
+	return mapEq(exp, val, "cmp.Equal function", eq, cmpDiff[V](opts), limit)
 }
 
-func MapEqFunc[M1, M2 interfaces.Map[K, V], K comparable, V any](exp M1, val M2, eq func(V, V) bool) (s string) {
-	lenA, lenB := len(exp), len(val)
-
-	if lenA != lenB {
-		s = "expected maps of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		return
-	}
-
-	for key, valA := range exp {
-		valB, exists := val[key]
-		if !exists {
-			s = "expected maps of same keys\n"
-			s += diff(exp, val, nil)
-			return
-		}
-
-		if !eq(valA, valB) {
-			s = "expected maps of same values via 'eq' function\n"
-			s += diff(exp, val, nil)
-			return
-		}
-	}
-	return
+func MapEqFunc[M1, M2 interfaces.Map[K, V], K comparable, V any](exp M1, val M2, eq func(V, V) bool, limit int) (f *Failure) {
+	return mapEq(exp, val, "'eq' function", eq, noDiff[V], limit)
 }
 
-func MapEqual[M interfaces.MapEqualFunc[K, V], K comparable, V interfaces.EqualFunc[V]](exp, val M) (s string) {
-	lenA, lenB := len(exp), len(val)
-
-	if lenA != lenB {
-		s = "expected maps of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		return
-	}
-
-	for key, valA := range exp {
-		valB, exists := val[key]
-		if !exists {
-			s = "expected maps of same keys\n"
-			s += diff(exp, val, nil)
-			return
-		}
-
-		if !(valB).Equal(valA) {
-			s = "expected maps of same values via .Equal method\n"
-			s += diff(exp, val, nil)
-			return
-		}
+func MapEqual[M interfaces.MapEqualFunc[K, V], K comparable, V interfaces.EqualFunc[V]](exp, val M, limit int) (f *Failure) {
+	eq := func(a, b V) bool {
+		return b.Equal(a)
 	}
-
-	return
+	return mapEq(exp, val, ".Equal method", eq, noDiff[V], limit)
 }
 
-func MapEqOp[M interfaces.Map[K, V], K, V comparable](exp, val M) (s string) {
-	lenA, lenB := len(exp), len(val)
-
-	if lenA != lenB {
-		s = "expected maps of same length\n"
-		s += bullet("len(exp): %d\n", lenA)
-		s += bullet("len(val): %d\n", lenB)
-		return
-	}
-
-	for key, valA := range exp {
-		valB, exists := val[key]
-		if !exists {
-			s = "expected maps of same keys\n"
-			s += diff(exp, val, nil)
-			return
-		}
-
-		if valA != valB {
-			s = "expected maps of same values via ==\n"
-			s += diff(exp, val, nil)
-			return
-		}
+func MapEqOp[M interfaces.Map[K, V], K, V comparable](exp, val M, limit int) (f *Failure) {
+	eq := func(a, b V) bool {
+		return a == b
 	}
-
-	return
+	return mapEq(exp, val, "==", eq, noDiff[V], limit)
 }
 
-func MapLen[M ~map[K]V, K comparable, V any](n int, m M) (s string) {
+func MapLen[M ~map[K]V, K comparable, V any](n int, m M) (f *Failure) {
 	if l := len(m); l != n {
-		s = "expected map to be different length\n"
-		s += bullet("len(map): %d, expected: %d\n", l, n)
+		f = failure("expected map to be different length\n")
+		f.bullet("len(map): %d, expected: %d\n", l, n)
 	}
 	return
 }
 
-func MapEmpty[M ~map[K]V, K comparable, V any](m M) (s string) {
+func MapEmpty[M ~map[K]V, K comparable, V any](m M) (f *Failure) {
 	if l := len(m); l > 0 {
-		s = "expected map to be empty\n"
-		s += bullet("len(map): %d\n", l)
+		f = failure("expected map to be empty\n")
+		f.bullet("len(map): %d\n", l)
 	}
 	return
 }
 
-func MapNotEmpty[M ~map[K]V, K comparable, V any](m M) (s string) {
+func MapNotEmpty[M ~map[K]V, K comparable, V any](m M) (f *Failure) {
 	if l := len(m); l == 0 {
-		s = "expected map to not be empty\n"
-		s += bullet("len(map): %d\n", l)
+		f = failure("expected map to not be empty\n")
+		f.bullet("len(map): %d\n", l)
 	}
 	return
 }
 
-func MapContainsKey[M ~map[K]V, K comparable, V any](m M, key K) (s string) {
+func MapContainsKey[M ~map[K]V, K comparable, V any](m M, key K) (f *Failure) {
 	if _, exists := m[key]; !exists {
-		s = "expected map to contain key\n"
-		s += bullet("key: %v\n", key)
+		f = failure("expected map to contain key\n")
+		f.bullet("key: %v\n", key)
 	}
 	return
 }
 
-func MapNotContainsKey[M ~map[K]V, K comparable, V any](m M, key K) (s string) {
+func MapNotContainsKey[M ~map[K]V, K comparable, V any](m M, key K) (f *Failure) {
 	if _, exists := m[key]; exists {
-		s = "expected map to not contain key\n"
-		s += bullet("key: %v\n", key)
+		f = failure("expected map to not contain key\n")
+		f.bullet("key: %v\n", key)
 	}
 	return
 }
 
-func MapContainsKeys[M ~map[K]V, K comparable, V any](m M, keys []K) (s string) {
+func MapContainsKeys[M ~map[K]V, K comparable, V any](m M, keys []K) (f *Failure) {
 	var missing []K
 	for _, key := range keys {
 		if _, exists := m[key]; !exists {
@@ -1034,15 +1176,15 @@ func MapContainsKeys[M ~map[K]V, K comparable, V any](m M, keys []K) (s string)
 		}
 	}
 	if len(missing) > 0 {
-		s = "expected map to contain keys\n"
+		f = failure("expected map to contain keys\n")
 		for _, key := range missing {
-			s += bullet("key: %v\n", key)
+			f.bullet("key: %v\n", key)
 		}
 	}
 	return
 }
 
-func MapNotContainsKeys[M ~map[K]V, K comparable, V any](m M, keys []K) (s string) {
+func MapNotContainsKeys[M ~map[K]V, K comparable, V any](m M, keys []K) (f *Failure) {
 	var unwanted []K
 	for _, key := range keys {
 		if _, exists := m[key]; exists {
@@ -1050,15 +1192,15 @@ func MapNotContainsKeys[M ~map[K]V, K comparable, V any](m M, keys []K) (s strin
 		}
 	}
 	if len(unwanted) > 0 {
-		s = "expected map to not contain keys\n"
+		f = failure("expected map to not contain keys\n")
 		for _, key := range unwanted {
-			s += bullet("key: %v\n", key)
+			f.bullet("key: %v\n", key)
 		}
 	}
 	return
 }
 
-func mapContains[M ~map[K]V, K comparable, V any](m M, values []V, eq func(V, V) bool) (s string) {
+func mapContains[M ~map[K]V, K comparable, V any](m M, values []V, eq func(V, V) bool) (f *Failure) {
 	var missing []V
 	for _, wanted := range values {
 		found := false
@@ -1074,15 +1216,15 @@ func mapContains[M ~map[K]V, K comparable, V any](m M, values []V, eq func(V, V)
 	}
 
 	if len(missing) > 0 {
-		s = "expected map to contain values\n"
+		f = failure("expected map to contain values\n")
 		for _, val := range missing {
-			s += bullet("val: %v\n", val)
+			f.bullet("val: %v\n", val)
 		}
 	}
 	return
 }
 
-func mapNotContains[M ~map[K]V, K comparable, V any](m M, values []V, eq func(V, V) bool) (s string) {
+func mapNotContains[M ~map[K]V, K comparable, V any](m M, values []V, eq func(V, V) bool) (f *Failure) {
 	var unexpected []V
 	for _, target := range values {
 		found := false
@@ -1097,432 +1239,477 @@ func mapNotContains[M ~map[K]V, K comparable, V any](m M, values []V, eq func(V,
 		}
 	}
 	if len(unexpected) > 0 {
-		s = "expected map to not contain values\n"
+		f = failure("expected map to not contain values\n")
 		for _, val := range unexpected {
-			s += bullet("val: %v\n", val)
+			f.bullet("val: %v\n", val)
 		}
 	}
 	return
 }
 
-func MapContainsValues[M ~map[K]V, K comparable, V any](m M, vals []V, opts cmp.Options) (s string) {
+func MapContainsValues[M ~map[K]V, K comparable, V any](m M, vals []V, opts cmp.Options) (f *Failure) {
 	return mapContains(m, vals, func(a, b V) bool {
 		return equal(a, b, opts)
 	})
 }
 
-func MapNotContainsValues[M ~map[K]V, K comparable, V any](m M, vals []V, opts cmp.Options) (s string) {
+func MapNotContainsValues[M ~map[K]V, K comparable, V any](m M, vals []V, opts cmp.Options) (f *Failure) {
 	return mapNotContains(m, vals, func(a, b V) bool {
 		return equal(a, b, opts)
 	})
 }
 
-func MapContainsValuesFunc[M ~map[K]V, K comparable, V any](m M, vals []V, eq func(V, V) bool) (s string) {
+func MapContainsValuesFunc[M ~map[K]V, K comparable, V any](m M, vals []V, eq func(V, V) bool) (f *Failure) {
 	return mapContains(m, vals, eq)
 }
 
-func MapNotContainsValuesFunc[M ~map[K]V, K comparable, V any](m M, vals []V, eq func(V, V) bool) (s string) {
+func MapNotContainsValuesFunc[M ~map[K]V, K comparable, V any](m M, vals []V, eq func(V, V) bool) (f *Failure) {
 	return mapNotContains(m, vals, eq)
 }
 
-func MapContainsValuesEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, vals []V) (s string) {
+func MapContainsValuesEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, vals []V) (f *Failure) {
 	return mapContains(m, vals, func(a, b V) bool {
 		return a.Equal(b)
 	})
 }
 
-func MapNotContainsValuesEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, vals []V) (s string) {
+func MapNotContainsValuesEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, vals []V) (f *Failure) {
 	return mapNotContains(m, vals, func(a, b V) bool {
 		return a.Equal(b)
 	})
 }
 
-func MapContainsValue[M ~map[K]V, K comparable, V any](m M, val V, opts cmp.Options) (s string) {
+func MapContainsValue[M ~map[K]V, K comparable, V any](m M, val V, opts cmp.Options) (f *Failure) {
 	return mapContains(m, []V{val}, func(a, b V) bool {
 		return equal(a, b, opts)
 	})
 }
 
-func MapNotContainsValue[M ~map[K]V, K comparable, V any](m M, val V, opts cmp.Options) (s string) {
+func MapNotContainsValue[M ~map[K]V, K comparable, V any](m M, val V, opts cmp.Options) (f *Failure) {
 	return mapNotContains(m, []V{val}, func(a, b V) bool {
 		return equal(a, b, opts)
 	})
 }
 
-func MapContainsValueFunc[M ~map[K]V, K comparable, V any](m M, val V, eq func(V, V) bool) (s string) {
+func MapContainsValueFunc[M ~map[K]V, K comparable, V any](m M, val V, eq func(V, V) bool) (f *Failure) {
 	return mapContains(m, []V{val}, eq)
 }
 
-func MapNotContainsValueFunc[M ~map[K]V, K comparable, V any](m M, val V, eq func(V, V) bool) (s string) {
+func MapNotContainsValueFunc[M ~map[K]V, K comparable, V any](m M, val V, eq func(V, V) bool) (f *Failure) {
 	return mapNotContains(m, []V{val}, eq)
 }
 
-func MapContainsValueEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, val V) (s string) {
+func MapContainsValueEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, val V) (f *Failure) {
 	return mapContains(m, []V{val}, func(a, b V) bool {
 		return a.Equal(b)
 	})
 }
 
-func MapNotContainsValueEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, val V) (s string) {
+func MapNotContainsValueEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V]](m M, val V) (f *Failure) {
 	return mapNotContains(m, []V{val}, func(a, b V) bool {
 		return a.Equal(b)
 	})
 }
 
-func FileExists(file string) (s string) {
+func FileExists(file string) (f *Failure) {
 	info, err := os.Stat(file)
 	if errors.Is(err, fs.ErrNotExist) {
-		s = "expected file to exist\n"
-		s += bullet(" name: %s\n", file)
-		s += bullet("error: %s\n", err)
+		f = failure("expected file to exist\n")
+		f.bullet(" name: %s\n", file)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if err != nil {
-		s = "got an unexpected error\n"
-		s += bullet("name: %s\n", file)
-		s += bullet("error: %s\n", err)
+		f = failure("got an unexpected error\n")
+		f.bullet("name: %s\n", file)
+		f.bullet("error: %s\n", err)
 		return
 	}
 
 	if info.IsDir() {
-		s = "expected file but is a directory\n"
-		s += bullet("name: %s\n", file)
+		f = failure("expected file but is a directory\n")
+		f.bullet("name: %s\n", file)
 		return
 	}
 	return
 }
 
-func FileExistsFS(system fs.FS, file string) (s string) {
+func FileExistsFS(system fs.FS, file string) (f *Failure) {
 	info, err := fs.Stat(system, file)
 	if errors.Is(err, fs.ErrNotExist) {
-		s = "expected file to exist\n"
-		s += bullet(" name: %s\n", file)
-		s += bullet("error: %s\n", err)
+		f = failure("expected file to exist\n")
+		f.bullet(" name: %s\n", file)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if err != nil {
-		s = "got an unexpected error\n"
-		s += bullet("name: %s\n", file)
-		s += bullet("error: %s\n", err)
+		f = failure("got an unexpected error\n")
+		f.bullet("name: %s\n", file)
+		f.bullet("error: %s\n", err)
 		return
 	}
 
 	if info.IsDir() {
-		s = "expected file but is a directory\n"
-		s += bullet("name: %s\n", file)
+		f = failure("expected file but is a directory\n")
+		f.bullet("name: %s\n", file)
 		return
 	}
 	return
 }
 
-func FileNotExists(file string) (s string) {
+func FileNotExists(file string) (f *Failure) {
 	_, err := os.Stat(file)
 	if err == nil {
-		s = "expected file to not exist\n"
-		s += bullet("name: %s\n", file)
+		f = failure("expected file to not exist\n")
+		f.bullet("name: %s\n", file)
 		return
 	}
 	if !errors.Is(err, fs.ErrNotExist) {
-		s = "expected not existing file but got different error\n"
-		s += bullet("error: %s\n", err)
+		f = failure("expected not existing file but got different error\n")
+		f.bullet("error: %s\n", err)
 		return
 	}
 	return
 }
 
-func FileNotExistsFS(system fs.FS, file string) (s string) {
+func FileNotExistsFS(system fs.FS, file string) (f *Failure) {
 	_, err := fs.Stat(system, file)
 	if err == nil {
-		s = "expected file to not exist\n"
-		s += bullet("name: %s\n", file)
+		f = failure("expected file to not exist\n")
+		f.bullet("name: %s\n", file)
 		return
 	}
 	if !errors.Is(err, fs.ErrNotExist) {
-		s = "expected not existing file but got different error\n"
-		s += bullet("error: %s\n", err)
+		f = failure("expected not existing file but got different error\n")
+		f.bullet("error: %s\n", err)
 		return
 	}
 	return
 }
 
-func DirExists(directory string) (s string) {
+func DirExists(directory string) (f *Failure) {
 	info, err := os.Stat(directory)
 	if errors.Is(err, fs.ErrNotExist) {
-		s = "expected directory to exist\n"
-		s += bullet(" name: %s\n", directory)
-		s += bullet("error: %s\n", err)
+		f = failure("expected directory to exist\n")
+		f.bullet(" name: %s\n", directory)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if err != nil {
-		s = "got an unexpected error\n"
-		s += bullet("name: %s\n", directory)
-		s += bullet("error: %s\n", err)
+		f = failure("got an unexpected error\n")
+		f.bullet("name: %s\n", directory)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if !info.IsDir() {
-		s = "expected directory but is a file\n"
-		s += bullet("name: %s\n", directory)
+		f = failure("expected directory but is a file\n")
+		f.bullet("name: %s\n", directory)
 		return
 	}
 	return
 }
 
-func DirExistsFS(system fs.FS, directory string) (s string) {
+func DirExistsFS(system fs.FS, directory string) (f *Failure) {
 	info, err := fs.Stat(system, directory)
 	if errors.Is(err, fs.ErrNotExist) {
-		s = "expected directory to exist\n"
-		s += bullet(" name: %s\n", directory)
-		s += bullet("error: %s\n", err)
+		f = failure("expected directory to exist\n")
+		f.bullet(" name: %s\n", directory)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if err != nil {
-		s = "got an unexpected error\n"
-		s += bullet("name: %s\n", directory)
-		s += bullet("error: %s\n", err)
+		f = failure("got an unexpected error\n")
+		f.bullet("name: %s\n", directory)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if !info.IsDir() {
-		s = "expected directory but is a file\n"
-		s += bullet("name: %s\n", directory)
+		f = failure("expected directory but is a file\n")
+		f.bullet("name: %s\n", directory)
 		return
 	}
 	return
 }
 
-func DirNotExists(directory string) (s string) {
+func DirNotExists(directory string) (f *Failure) {
 	_, err := os.Stat(directory)
 	if !errors.Is(err, fs.ErrNotExist) {
-		s = "expected directory to not exist\n"
-		s += bullet("name: %s\n", directory)
+		f = failure("expected directory to not exist\n")
+		f.bullet("name: %s\n", directory)
 		return
 	}
 	return
 }
 
-func DirNotExistsFS(system fs.FS, directory string) (s string) {
+func DirNotExistsFS(system fs.FS, directory string) (f *Failure) {
 	_, err := fs.Stat(system, directory)
 	if !errors.Is(err, fs.ErrNotExist) {
-		s = "expected directory to not exist\n"
-		s += bullet("name: %s\n", directory)
+		f = failure("expected directory to not exist\n")
+		f.bullet("name: %s\n", directory)
 		return
 	}
 	return
 }
 
-func FileMode(path string, permissions fs.FileMode) (s string) {
+func FileMode(path string, permissions fs.FileMode) (f *Failure) {
 	info, err := os.Stat(path)
 	if err != nil {
-		s = "expected to stat path\n"
-		s += bullet(" name: %s\n", path)
-		s += bullet("error: %s\n", err)
+		f = failure("expected to stat path\n")
+		f.bullet(" name: %s\n", path)
+		f.bullet("error: %s\n", err)
 		return
 	}
 
 	mode := info.Mode()
 	if permissions != mode {
-		s = "expected different file permissions\n"
-		s += bullet("name: %s\n", path)
-		s += bullet(" exp: %s\n", permissions)
-		s += bullet(" got: %s\n", mode)
+		f = failure("expected different file permissions\n")
+		f.bullet("name: %s\n", path)
+		f.bullet(" exp: %s\n", permissions)
+		f.bullet(" got: %s\n", mode)
 	}
 	return
 }
 
-func FileModeFS(system fs.FS, path string, permissions fs.FileMode) (s string) {
+func FileModeFS(system fs.FS, path string, permissions fs.FileMode) (f *Failure) {
 	info, err := fs.Stat(system, path)
 	if err != nil {
-		s = "expected to stat path\n"
-		s += bullet(" name: %s\n", path)
-		s += bullet("error: %s\n", err)
+		f = failure("expected to stat path\n")
+		f.bullet(" name: %s\n", path)
+		f.bullet("error: %s\n", err)
 		return
 	}
 
 	mode := info.Mode()
 	if permissions != mode {
-		s = "expected different file permissions\n"
-		s += bullet("name: %s\n", path)
-		s += bullet(" exp: %s\n", permissions)
-		s += bullet(" got: %s\n", mode)
+		f = failure("expected different file permissions\n")
+		f.bullet("name: %s\n", path)
+		f.bullet(" exp: %s\n", permissions)
+		f.bullet(" got: %s\n", mode)
 	}
 	return
 }
 
-func DirMode(path string, permissions fs.FileMode) (s string) {
+func DirMode(path string, permissions fs.FileMode) (f *Failure) {
 	info, err := os.Stat(path)
 	if err != nil {
-		s = "expected to stat path\n"
-		s += bullet(" name: %s\n", path)
-		s += bullet("error: %s\n", err)
+		f = failure("expected to stat path\n")
+		f.bullet(" name: %s\n", path)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if !info.IsDir() {
-		s = "expected to stat a directory\n"
-		s += bullet("name: %s\n", path)
+		f = failure("expected to stat a directory\n")
+		f.bullet("name: %s\n", path)
 		return
 	}
 	mode := info.Mode()
 	if permissions != mode {
-		s = "expected different file permissions\n"
-		s += bullet("name: %s\n", path)
-		s += bullet(" exp: %s\n", permissions)
-		s += bullet(" got: %s\n", mode)
+		f = failure("expected different file permissions\n")
+		f.bullet("name: %s\n", path)
+		f.bullet(" exp: %s\n", permissions)
+		f.bullet(" got: %s\n", mode)
 	}
 	return
 }
 
-func DirModeFS(system fs.FS, path string, permissions fs.FileMode) (s string) {
+func DirModeFS(system fs.FS, path string, permissions fs.FileMode) (f *Failure) {
 	info, err := fs.Stat(system, path)
 	if err != nil {
-		s = "expected to stat path\n"
-		s += bullet(" name: %s\n", path)
-		s += bullet("error: %s\n", err)
+		f = failure("expected to stat path\n")
+		f.bullet(" name: %s\n", path)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	if !info.IsDir() {
-		s = "expected to stat a directory\n"
-		s += bullet("name: %s\n", path)
+		f = failure("expected to stat a directory\n")
+		f.bullet("name: %s\n", path)
 		return
 	}
 	mode := info.Mode()
 	if permissions != mode {
-		s = "expected different file permissions\n"
-		s += bullet("name: %s\n", path)
-		s += bullet(" exp: %s\n", permissions)
-		s += bullet(" got: %s\n", mode)
+		f = failure("expected different file permissions\n")
+		f.bullet("name: %s\n", path)
+		f.bullet(" exp: %s\n", permissions)
+		f.bullet(" got: %s\n", mode)
 	}
 	return
 }
 
-func FileContains(file, content string) (s string) {
+func FileContains(file, content string) (f *Failure) {
 	b, err := os.ReadFile(file)
 	if err != nil {
-		s = "expected to read file\n"
-		s += bullet(" name: %s\n", file)
-		s += bullet("error: %s\n", err)
+		f = failure("expected to read file\n")
+		f.bullet(" name: %s\n", file)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	actual := string(b)
 	if !strings.Contains(string(b), content) {
-		s = "expected file contents\n"
-		s += bullet("  name: %s\n", file)
-		s += bullet("wanted: %s\n", content)
-		s += bullet("actual: %s\n", actual)
+		f = failure("expected file contents\n")
+		f.bullet("  name: %s\n", file)
+		f.block("wanted", content)
+		f.block("actual", actual)
 		return
 	}
 	return
 }
 
-func FileContainsFS(system fs.FS, file, content string) (s string) {
+func FileContainsFS(system fs.FS, file, content string) (f *Failure) {
 	b, err := fs.ReadFile(system, file)
 	if err != nil {
-		s = "expected to read file\n"
-		s += bullet(" name: %s\n", file)
-		s += bullet("error: %s\n", err)
+		f = failure("expected to read file\n")
+		f.bullet(" name: %s\n", file)
+		f.bullet("error: %s\n", err)
 		return
 	}
 	actual := string(b)
 	if !strings.Contains(string(b), content) {
-		s = "expected file contents\n"
-		s += bullet("  name: %s\n", file)
-		s += bullet("wanted: %s\n", content)
-		s += bullet("actual: %s\n", actual)
+		f = failure("expected file contents\n")
+		f.bullet("  name: %s\n", file)
+		f.block("wanted", content)
+		f.block("actual", actual)
+		return
+	}
+	return
+}
+
+func Golden(file string, got []byte, update bool, normalize func(string) string) (f *Failure) {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			f = failure("expected to create golden file directory\n")
+			f.bullet(" name: %s\n", filepath.Dir(file))
+			f.bullet("error: %s\n", err)
+			return
+		}
+		if err := os.WriteFile(file, got, 0o644); err != nil {
+			f = failure("expected to write golden file\n")
+			f.bullet(" name: %s\n", file)
+			f.bullet("error: %s\n", err)
+		}
+		return
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		f = failure("expected to read golden file\n")
+		f.bullet(" name: %s\n", file)
+		f.bullet("error: %s\n", err)
+		f.bullet(" hint: run with -update or TEST_UPDATE_GOLDEN=1 to create\n")
 		return
 	}
+	exp, val := normalize(string(b)), normalize(string(got))
+	if exp != val {
+		f = failure("expected content to match golden file\n")
+		f.bullet("name: %s\n", file)
+		f.Expected = exp
+		f.Actual = val
+		f.Diff = unified("golden", "got", exp, val)
+	}
 	return
 }
 
-func FilePathValid(path string) (s string) {
+func GoldenJSON(file string, v any, update bool, normalize func(string) string) (f *Failure) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		f = failure("expected to encode value as json\n")
+		f.bullet("error: %s\n", err)
+		return
+	}
+	return Golden(file, append(b, '\n'), update, normalize)
+}
+
+func FilePathValid(path string) (f *Failure) {
 	if !fs.ValidPath(path) {
-		s = "expected valid file path\n"
+		f = failure("expected valid file path\n")
 	}
 	return
 }
 
-func Close(c io.Closer) (s string) {
+func Close(c io.Closer) (f *Failure) {
 	err := c.Close()
 	if err != nil {
-		s = "calling Close failed\n"
-		s += bullet("error: %v\n", err)
+		f = failure("calling Close failed\n")
+		f.bullet("error: %v\n", err)
 	}
 	return
 }
 
-func StrEqFold(exp, val string) (s string) {
+func StrEqFold(exp, val string) (f *Failure) {
 	if !strings.EqualFold(exp, val) {
-		s = "expected strings to be equal ignoring case\n"
-		s += bullet("exp: %s\n", exp)
-		s += bullet("val: %s\n", val)
+		f = failure("expected strings to be equal ignoring case\n")
+		f.bullet("exp: %s\n", exp)
+		f.bullet("val: %s\n", val)
 	}
 	return
 }
 
-func StrNotEqFold(exp, val string) (s string) {
+func StrNotEqFold(exp, val string) (f *Failure) {
 	if strings.EqualFold(exp, val) {
-		s = "expected strings to not be equal ignoring case; but they are\n"
-		s += bullet("exp: %s\n", exp)
-		s += bullet("val: %s\n", val)
+		f = failure("expected strings to not be equal ignoring case; but they are\n")
+		f.bullet("exp: %s\n", exp)
+		f.bullet("val: %s\n", val)
 	}
 	return
 }
 
-func StrContains(str, sub string) (s string) {
+func StrContains(str, sub string) (f *Failure) {
 	if !strings.Contains(str, sub) {
-		s = "expected string to contain substring; it does not\n"
-		s += bullet("substring: %s\n", sub)
-		s += bullet("   string: %s\n", str)
+		f = failure("expected string to contain substring; it does not\n")
+		f.bullet("substring: %s\n", sub)
+		f.block("   string", str)
 	}
 	return
 }
 
-func StrContainsFold(str, sub string) (s string) {
+func StrContainsFold(str, sub string) (f *Failure) {
 	upperS := strings.ToUpper(str)
 	upperSub := strings.ToUpper(sub)
 	return StrContains(upperS, upperSub)
 }
 
-func StrNotContains(str, sub string) (s string) {
+func StrNotContains(str, sub string) (f *Failure) {
 	if strings.Contains(str, sub) {
-		s = "expected string to not contain substring; but it does\n"
-		s += bullet("substring: %s\n", sub)
-		s += bullet("   string: %s\n", str)
+		f = failure("expected string to not contain substring; but it does\n")
+		f.bullet("substring: %s\n", sub)
+		f.block("   string", str)
 	}
 	return
 }
 
-func StrNotContainsFold(str, sub string) (s string) {
+func StrNotContainsFold(str, sub string) (f *Failure) {
 	upperS := strings.ToUpper(str)
 	upperSub := strings.ToUpper(sub)
 	return StrNotContains(upperS, upperSub)
 }
 
-func StrContainsAny(str, chars string) (s string) {
+func StrContainsAny(str, chars string) (f *Failure) {
 	if !strings.ContainsAny(str, chars) {
-		s = "expected string to contain one or more code points\n"
-		s += bullet("code-points: %s\n", chars)
-		s += bullet("     string: %s\n", str)
+		f = failure("expected string to contain one or more code points\n")
+		f.bullet("code-points: %s\n", chars)
+		f.bullet("     string: %s\n", str)
 	}
 	return
 }
 
-func StrNotContainsAny(str, chars string) (s string) {
+func StrNotContainsAny(str, chars string) (f *Failure) {
 	if strings.ContainsAny(str, chars) {
-		s = "expected string to not contain code points; but it does\n"
-		s += bullet("code-points: %s\n", chars)
-		s += bullet("     string: %s\n", str)
+		f = failure("expected string to not contain code points; but it does\n")
+		f.bullet("code-points: %s\n", chars)
+		f.bullet("     string: %s\n", str)
 	}
 	return
 }
 
-func StrCount(str, sub string, exp int) (s string) {
+func StrCount(str, sub string, exp int) (f *Failure) {
 	count := strings.Count(str, sub)
 	if count != exp {
-		s = fmt.Sprintf("expected string to contain %d non-overlapping cases of substring\n", exp)
-		s += bullet("count: %d\n", count)
+		f = failure("expected string to contain %d non-overlapping cases of substring\n", exp)
+		f.bullet("count: %d\n", count)
 	}
 	return
 }
 
-func StrContainsFields(str string, fields []string) (s string) {
+func StrContainsFields(str string, fields []string) (f *Failure) {
 	set := make(map[string]struct{}, len(fields))
 	for _, field := range strings.Fields(str) {
 		set[field] = struct{}{}
@@ -1534,71 +1721,71 @@ func StrContainsFields(str string, fields []string) (s string) {
 		}
 	}
 	if len(missing) > 0 {
-		s = "expected fields of string to contain subset of values\n"
-		s += bullet("missing: %s\n", strings.Join(missing, ", "))
+		f = failure("expected fields of string to contain subset of values\n")
+		f.bullet("missing: %s\n", strings.Join(missing, ", "))
 	}
 	return
 }
 
-func StrHasPrefix(prefix, str string) (s string) {
+func StrHasPrefix(prefix, str string) (f *Failure) {
 	if !strings.HasPrefix(str, prefix) {
-		s = "expected string to have prefix\n"
-		s += bullet("prefix: %s\n", prefix)
-		s += bullet("string: %s\n", str)
+		f = failure("expected string to have prefix\n")
+		f.bullet("prefix: %s\n", prefix)
+		f.bullet("string: %s\n", str)
 	}
 	return
 }
 
-func StrNotHasPrefix(prefix, str string) (s string) {
+func StrNotHasPrefix(prefix, str string) (f *Failure) {
 	if strings.HasPrefix(str, prefix) {
-		s = "expected string to not have prefix; but it does\n"
-		s += bullet("prefix: %s\n", prefix)
-		s += bullet("string: %s\n", str)
+		f = failure("expected string to not have prefix; but it does\n")
+		f.bullet("prefix: %s\n", prefix)
+		f.bullet("string: %s\n", str)
 	}
 	return
 }
 
-func StrHasSuffix(suffix, str string) (s string) {
+func StrHasSuffix(suffix, str string) (f *Failure) {
 	if !strings.HasSuffix(str, suffix) {
-		s = "expected string to have suffix\n"
-		s += bullet("suffix: %s\n", suffix)
-		s += bullet("string: %s\n", str)
+		f = failure("expected string to have suffix\n")
+		f.bullet("suffix: %s\n", suffix)
+		f.bullet("string: %s\n", str)
 	}
 	return
 }
 
-func StrNotHasSuffix(suffix, str string) (s string) {
+func StrNotHasSuffix(suffix, str string) (f *Failure) {
 	if strings.HasSuffix(str, suffix) {
-		s = "expected string to not have suffix; but it does\n"
-		s += bullet("suffix: %s\n", suffix)
-		s += bullet("string: %s\n", str)
+		f = failure("expected string to not have suffix; but it does\n")
+		f.bullet("suffix: %s\n", suffix)
+		f.bullet("string: %s\n", str)
 	}
 	return
 }
 
-func RegexMatch(re *regexp.Regexp, target string) (s string) {
+func RegexMatch(re *regexp.Regexp, target string) (f *Failure) {
 	if !re.MatchString(target) {
-		s = "expected regexp match\n"
-		s += bullet(" regex: %s\n", re)
-		s += bullet("string: %s\n", target)
+		f = failure("expected regexp match\n")
+		f.bullet(" regex: %s\n", re)
+		f.bullet("string: %s\n", target)
 	}
 	return
 }
 
-func RegexpCompiles(expr string) (s string) {
+func RegexpCompiles(expr string) (f *Failure) {
 	if _, err := regexp.Compile(expr); err != nil {
-		s = "expected regular expression to compile\n"
-		s += bullet("regex: %s\n", expr)
-		s += bullet("error: %v\n", err)
+		f = failure("expected regular expression to compile\n")
+		f.bullet("regex: %s\n", expr)
+		f.bullet("error: %v\n", err)
 	}
 	return
 }
 
-func RegexpCompilesPOSIX(expr string) (s string) {
+func RegexpCompilesPOSIX(expr string) (f *Failure) {
 	if _, err := regexp.CompilePOSIX(expr); err != nil {
-		s = "expected regular expression to compile (posix)\n"
-		s += bullet("regex: %s\n", expr)
-		s += bullet("error: %v\n", err)
+		f = failure("expected regular expression to compile (posix)\n")
+		f.bullet("regex: %s\n", expr)
+		f.bullet("error: %v\n", err)
 	}
 	return
 }
@@ -1606,79 +1793,279 @@ func RegexpCompilesPOSIX(expr string) (s string) {
 // a10b173d-1427-432d-8a27-b12eada42feb
 var uuid4Re = regexp.MustCompile(`^[[:xdigit:]]{8}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{12}$`)
 
-func UUIDv4(id string) (s string) {
+func UUIDv4(id string) (f *Failure) {
 	if !uuid4Re.MatchString(id) {
-		s = "expected well-formed v4 UUID\n"
-		s += bullet("format: XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX\n")
-		s += bullet("actual: " + id + "\n")
+		f = failure("expected well-formed v4 UUID\n")
+		f.bullet("format: XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX\n")
+		f.bullet("actual: %s\n", id)
 	}
 	return
 }
 
-func Length(n int, length interfaces.LengthFunc) (s string) {
+func Length(n int, length interfaces.LengthFunc) (f *Failure) {
 	if l := length.Len(); l != n {
-		s = "expected different length\n"
-		s += bullet("  length: %d\n", l)
-		s += bullet("expected: %d\n", n)
+		f = failure("expected different length\n")
+		f.bullet("  length: %d\n", l)
+		f.bullet("expected: %d\n", n)
 	}
 	return
 }
 
-func Size(n int, size interfaces.SizeFunc) (s string) {
+func Size(n int, size interfaces.SizeFunc) (f *Failure) {
 	if l := size.Size(); l != n {
-		s = "expected different size\n"
-		s += bullet("    size: %d\n", l)
-		s += bullet("expected: %d\n", n)
+		f = failure("expected different size\n")
+		f.bullet("    size: %d\n", l)
+		f.bullet("expected: %d\n", n)
 	}
 	return
 }
 
-func Empty(e interfaces.EmptyFunc) (s string) {
+func Empty(e interfaces.EmptyFunc) (f *Failure) {
 	if !e.Empty() {
-		s = "expected to be empty, but was not\n"
+		f = failure("expected to be empty, but was not\n")
 	}
 	return
 }
 
-func NotEmpty(e interfaces.EmptyFunc) (s string) {
+func NotEmpty(e interfaces.EmptyFunc) (f *Failure) {
 	if e.Empty() {
-		s = "expected to not be empty, but is\n"
+		f = failure("expected to not be empty, but is\n")
 	}
 	return
 }
 
-func Contains[C any](i C, c interfaces.ContainsFunc[C]) (s string) {
+func Contains[C any](i C, c interfaces.ContainsFunc[C]) (f *Failure) {
 	if !c.Contains(i) {
-		s = "expected to contain element, but does not\n"
+		f = failure("expected to contain element, but does not\n")
 	}
 	return
 }
 
-func ContainsSubset[C any](elements []C, container interfaces.ContainsFunc[C]) (s string) {
+func ContainsSubset[C any](elements []C, container interfaces.ContainsFunc[C]) (f *Failure) {
 	for i := 0; i < len(elements); i++ {
 		element := elements[i]
 		if !container.Contains(element) {
-			s = "expected to contain element, but does not\n"
-			s += bullet("element: %v\n", element)
+			f = failure("expected to contain element, but does not\n")
+			f.bullet("element: %v\n", element)
 			return
 		}
 	}
 	return
 }
 
-func NotContains[C any](i C, c interfaces.ContainsFunc[C]) (s string) {
+func NotContains[C any](i C, c interfaces.ContainsFunc[C]) (f *Failure) {
 	if c.Contains(i) {
-		s = "expected not to contain element, but it does\n"
+		f = failure("expected not to contain element, but it does\n")
 	}
 	return
 }
 
-func Wait(wc *wait.Constraint) (s string) {
+func Wait(wc *wait.Constraint) (f *Failure) {
 	err := wc.Run()
 	if err != nil {
-		s = "expected condition to pass within wait context\n"
-		s += bullet("error: %v\n", err)
-		// context info?
+		f = failure("expected condition to pass within wait context\n")
+		f.bullet("error: %v\n", err)
+		history(f, wc.Report())
+	}
+	return
+}
+
+func Eventually(wc *wait.Constraint, last func() []*Failure) (f *Failure) {
+	return attempted(wc, "eventually", last)
+}
+
+func Consistently(wc *wait.Constraint, last func() []*Failure) (f *Failure) {
+	return attempted(wc, "consistently", last)
+}
+
+// attempted runs wc, whose attempts are made of assertions, describing the
+// failures of the last attempt (as given by last) if wc produces an error.
+func attempted(wc *wait.Constraint, how string, last func() []*Failure) (f *Failure) {
+	err := wc.Run()
+	if err == nil {
+		return nil
+	}
+	f = failure("expected assertions to pass %s\n", how)
+	f.bullet("error: %v\n", err)
+	history(f, wc.Report())
+	if failures := last(); len(failures) > 0 {
+		lines := strings.Split(Report(failures...), "\n")
+		for i := range lines {
+			lines[i] = "  " + lines[i]
+		}
+		f.bullet("last attempt ↷\n%s\n", strings.Join(lines, "\n"))
+	}
+	return
+}
+
+// shownErrors is how many of the first and of the last distinct errors of a
+// wait.Report are shown.
+const shownErrors = 3
+
+// history adds bullets describing the attempts of report to f.
+func history(f *Failure, report wait.Report) {
+	f.bullet("attempts: %d\n", report.Total)
+	f.bullet("elapsed: %s\n", report.Elapsed.Round(time.Millisecond))
+	if report.Continual {
+		f.bullet("held: %s\n", report.Held().Round(time.Millisecond))
+	}
+	distinct := report.Distinct()
+	if len(distinct) == 0 {
+		return
+	}
+	f.bullet("distinct errors: %d\n", len(distinct))
+	show := func(a wait.Attempt) {
+		f.bullet("  [attempt %d] %v\n", a.Number, a.Err)
+	}
+	if len(distinct) <= 2*shownErrors {
+		for _, a := range distinct {
+			show(a)
+		}
+		return
+	}
+	for _, a := range distinct[:shownErrors] {
+		show(a)
+	}
+	f.bullet("  … %d more\n", len(distinct)-2*shownErrors)
+	for _, a := range distinct[len(distinct)-shownErrors:] {
+		show(a)
+	}
+}
+
+// poll is the gap between attempts to receive from a channel.
+const poll = time.Millisecond
+
+// receive waits up to timeout for a value to be received from ch; ok is false
+// if ch is closed.
+func receive[A any](ch <-chan A, timeout time.Duration) (value A, ok bool, err error) {
+	err = wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			select {
+			case value, ok = <-ch:
+				return true
+			default:
+				return false
+			}
+		}),
+		wait.Timeout(timeout),
+		wait.Gap(poll),
+	).Run()
+	return
+}
+
+func Receives[A any](ch <-chan A, exp A, timeout time.Duration, opts ...cmp.Option) (f *Failure) {
+	val, ok, err := receive(ch, timeout)
+	switch {
+	case err != nil:
+		f = failure("expected to receive value within %s\n", timeout)
+		f.bullet("exp: %#v\n", exp)
+	case !ok:
+		f = failure("expected to receive value; channel is closed\n")
+		f.bullet("exp: %#v\n", exp)
+	case !equal(exp, val, opts):
+		f = failure("expected received value equality via cmp.Equal function\n")
+		f.diff(exp, val, opts)
+	}
+	return
+}
+
+func ReceivesWithin[A any](ch <-chan A, timeout time.Duration) (f *Failure) {
+	_, ok, err := receive(ch, timeout)
+	switch {
+	case err != nil:
+		f = failure("expected to receive value within %s\n", timeout)
+	case !ok:
+		f = failure("expected to receive value; channel is closed\n")
+	}
+	return
+}
+
+func NoReceive[A any](ch <-chan A, duration time.Duration) (f *Failure) {
+	var (
+		val A
+		ok  bool
+	)
+	err := wait.ContinualSuccess(
+		wait.BoolFunc(func() bool {
+			select {
+			case val, ok = <-ch:
+				return false
+			default:
+				return true
+			}
+		}),
+		wait.Timeout(duration),
+		wait.Gap(poll),
+	).Run()
+	switch {
+	case err == nil:
+	case !ok:
+		f = failure("expected to receive no value; channel is closed\n")
+	default:
+		f = failure("expected to receive no value within %s\n", duration)
+		f.bullet("received: %#v\n", val)
+	}
+	return
+}
+
+func Closed[A any](ch <-chan A, timeout time.Duration) (f *Failure) {
+	val, ok, err := receive(ch, timeout)
+	switch {
+	case err != nil:
+		f = failure("expected channel to be closed within %s\n", timeout)
+	case ok:
+		f = failure("expected channel to be closed; received value\n")
+		f.bullet("received: %#v\n", val)
+	}
+	return
+}
+
+func Drains[A any](ch <-chan A, n int, timeout time.Duration) (f *Failure) {
+	var received []A
+	closed := false
+	err := wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			for !closed && len(received) < n {
+				select {
+				case val, ok := <-ch:
+					if !ok {
+						closed = true
+						break
+					}
+					received = append(received, val)
+				default:
+					return false
+				}
+			}
+			return len(received) == n || closed
+		}),
+		wait.Timeout(timeout),
+		wait.Gap(poll),
+	).Run()
+	switch {
+	case err != nil:
+		f = failure("expected to receive %d values within %s\n", n, timeout)
+		f.bullet("received: %#v\n", received)
+	case len(received) < n:
+		f = failure("expected to receive %d values; channel is closed\n", n)
+		f.bullet("received: %#v\n", received)
+	}
+	return
+}
+
+func ChanLen[A any](n int, ch <-chan A) (f *Failure) {
+	if l := len(ch); l != n {
+		f = failure("expected channel to be different length\n")
+		f.bullet("len(ch): %d, expected: %d\n", l, n)
+	}
+	return
+}
+
+func Match[A any](value A, m interfaces.Matcher[A]) (f *Failure) {
+	if !m.Match(value) {
+		f = failure("expected value to satisfy matcher\n")
+		f.bullet("expected: %s\n", m.Describe())
+		f.bullet("  actual: %s\n", m.DescribeMismatch(value))
 	}
 	return
 }
@@ -1689,25 +2076,21 @@ type Tweak[E interfaces.CopyEqual[E]] struct {
 }
 
 // StructEqual will apply each Tweak and assert E.Equal captures the modification.
-func StructEqual[E interfaces.CopyEqual[E]](original E, tweaks []Tweak[E]) (s string) {
+func StructEqual[E interfaces.CopyEqual[E]](original E, tweaks []Tweak[E]) (f *Failure) {
 	for _, tweak := range tweaks {
 		if tweak.Field == "" {
-			return "Tweak.Field must be set"
+			return failure("Tweak.Field must be set")
 		} else if tweak.Apply == nil {
-			return "Tweak.Apply must be set"
+			return failure("Tweak.Apply must be set")
 		}
 		clone := original.Copy()
-		if s = Equal[E](original, clone); s != "" {
+		if f = Equal[E](original, clone); f != nil {
 			return
 		}
 		tweak.Apply(clone)
-		if s = NotEqual[E](original, clone); s != "" {
+		if f = NotEqual[E](original, clone); f != nil {
 			return
 		}
 	}
 	return
 }
-
-func bullet(msg string, args ...any) string {
-	return fmt.Sprintf("↪ "+msg, args...)
-}
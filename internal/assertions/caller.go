@@ -0,0 +1,188 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// library contains the packages implementing assertions, whose stack frames
+// are skipped over when looking for the caller of an assertion.
+var library = map[string]bool{
+	"github.com/shoenig/test":                     true,
+	"github.com/shoenig/test/must":                true,
+	"github.com/shoenig/test/expect":              true,
+	"github.com/shoenig/test/internal/assertions": true,
+}
+
+var (
+	helpersLock sync.RWMutex
+	helpers     = make(map[string]bool)
+)
+
+// RegisterHelpers adds packages implementing assertion helpers, whose stack
+// frames are skipped over when looking for the caller of an assertion.
+func RegisterHelpers(packages ...string) {
+	helpersLock.Lock()
+	defer helpersLock.Unlock()
+	for _, pkg := range packages {
+		helpers[pkg] = true
+	}
+}
+
+// UnregisterHelpers removes packages added by RegisterHelpers, e.g. so that
+// tests registering helpers do not affect one another.
+func UnregisterHelpers(packages ...string) {
+	helpersLock.Lock()
+	defer helpersLock.Unlock()
+	for _, pkg := range packages {
+		delete(helpers, pkg)
+	}
+}
+
+// caller returns the first stack frame outside of the assertion library, any
+// helper packages, and the Go runtime, i.e. the location of the test code making
+// the assertion (or panicking), along with the frame of the outermost library
+// function called, i.e. the assertion, and the frame of the outermost library or
+// helper function skipped over, i.e. the assertion or helper called by the test
+// code.
+func caller() (frame, assertion, outer runtime.Frame, ok bool) {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		next, more := frames.Next()
+		switch {
+		case inLibrary(next):
+			assertion = next
+			outer = next
+		case inHelper(next):
+			outer = next
+		case pkgOf(next.Function) == "runtime":
+			// e.g. the panic of test code recovered by the library
+		default:
+			return next, assertion, outer, true
+		}
+		if !more {
+			break
+		}
+	}
+	return frame, assertion, outer, false
+}
+
+// inLibrary reports whether frame belongs to a (non-test) file of one of the
+// library packages.
+func inLibrary(frame runtime.Frame) bool {
+	if strings.HasSuffix(frame.File, "_test.go") {
+		return false
+	}
+	return library[pkgOf(frame.Function)]
+}
+
+// inHelper reports whether frame belongs to a (non-test) file of one of the
+// registered helper packages.
+func inHelper(frame runtime.Frame) bool {
+	if strings.HasSuffix(frame.File, "_test.go") {
+		return false
+	}
+	helpersLock.RLock()
+	defer helpersLock.RUnlock()
+	return helpers[pkgOf(frame.Function)]
+}
+
+// pkgOf returns the package path of the fully qualified function name, e.g.
+// "github.com/shoenig/test.Eq[...]" is in "github.com/shoenig/test".
+func pkgOf(function string) string {
+	slash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[slash+1:], ".")
+	if dot < 0 {
+		return function
+	}
+	return function[:slash+1+dot]
+}
+
+var closureRe = regexp.MustCompile(`(\.func\d+)+(\.\d+)*$`)
+
+// funcName returns the bare name of the fully qualified function name, e.g.
+// "github.com/shoenig/test/expect.(*Value[...]).Eq" is "Eq".
+func funcName(function string) string {
+	name := strings.TrimPrefix(function, pkgOf(function)+".")
+	name = closureRe.ReplaceAllString(name, "")
+	name = strings.ReplaceAll(name, "[...]", "")
+	if dot := strings.LastIndex(name, "."); dot >= 0 {
+		name = name[dot+1:]
+	}
+	return name
+}
+
+// A Location is where an assertion is made by test code.
+type Location struct {
+	frame, assertion, outer runtime.Frame
+	ok                      bool
+}
+
+// Here returns the Location of the test code making the assertion currently
+// being executed, for when a Failure may only be created later.
+func Here() Location {
+	frame, assertion, outer, ok := caller()
+	return Location{frame: frame, assertion: assertion, outer: outer, ok: ok}
+}
+
+// Locate sets the File, Line, Kind, and Sources of f by inspecting the stack
+// and source of the test code making the assertion, unless f has already been
+// located.
+func (f *Failure) Locate() {
+	if f.File != "" {
+		return
+	}
+	f.At(Here())
+}
+
+// At sets the File, Line, Kind, and Sources of f to that of l.
+func (f *Failure) At(l Location) {
+	if l.ok {
+		f.File = filepath.Base(l.frame.File)
+		f.Line = l.frame.Line
+	}
+	if f.Kind == "" {
+		f.Kind = funcName(l.assertion.Function)
+	}
+	if l.ok && f.Sources == nil {
+		f.Sources = expressions(l.frame, l.outer)
+	}
+}
+
+// Stack captures the stack of the current goroutine as of the function calling
+// Stack, to be rendered later by FormatStack.
+func Stack() []uintptr {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	return pcs[:n]
+}
+
+// FormatStack renders the frames of pcs (as returned by Stack), omitting those
+// of the assertion library, helper packages, and the Go runtime and testing
+// packages.
+func FormatStack(pcs []uintptr) string {
+	s := new(strings.Builder)
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		switch pkg := pkgOf(frame.Function); {
+		case inLibrary(frame), inHelper(frame):
+		case pkg == "runtime", pkg == "testing":
+		default:
+			fmt.Fprintf(s, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+	return s.String()
+}
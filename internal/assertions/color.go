@@ -0,0 +1,89 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// ColorEnvVar is the environment variable used to explicitly enable or disable
+// colorized output, overriding detection of a terminal and NO_COLOR.
+const ColorEnvVar = "TEST_COLOR"
+
+const (
+	reset = "\x1b[0m"
+	red   = "\x1b[31m"
+	green = "\x1b[32m"
+	cyan  = "\x1b[36m"
+	dim   = "\x1b[2m"
+)
+
+// colorization is whether output is colorized by default; true if stdout is a
+// terminal and NO_COLOR is not set, unless set explicitly via TEST_COLOR.
+var colorization atomic.Bool
+
+func init() {
+	colorization.Store(detectColor())
+}
+
+// colorize returns whether output is colorized by default.
+func colorize() bool {
+	return colorization.Load()
+}
+
+// SetColor sets whether output is colorized by default, returning the previous
+// default.
+func SetColor(enabled bool) bool {
+	return colorization.Swap(enabled)
+}
+
+func detectColor() bool {
+	if enabled, err := strconv.ParseBool(os.Getenv(ColorEnvVar)); err == nil {
+		return enabled
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// paint wraps s in the given color, if enabled.
+func paint(enabled bool, color, s string) string {
+	if !enabled || s == "" {
+		return s
+	}
+	return color + s + reset
+}
+
+// paintDiff colors the removed and added lines of diff red and green, and any
+// headers dim, if enabled. Works with both cmp.Diff and unified diff output.
+func paintDiff(enabled bool, diff string) string {
+	if !enabled {
+		return diff
+	}
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+			lines[i] = paintLine(dim, line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = paintLine(cyan, line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = paintLine(red, line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = paintLine(green, line)
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// paintLine colors line while keeping its trailing newline uncolored.
+func paintLine(color, line string) string {
+	text := strings.TrimSuffix(line, "\n")
+	return paint(true, color, text) + line[len(text):]
+}
@@ -0,0 +1,125 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxIndexes is the number of indexes listed for each kind of element change
+// before the remainder are summarized.
+const maxIndexes = 10
+
+// elements records the element-wise difference of exp and val, aligned by the
+// longest common subsequence of elements as determined by eq. Only changed
+// elements and the few unchanged elements surrounding them are rendered, along
+// with bullets listing the indexes of deleted, inserted, and changed elements.
+// The Expected and Actual values as a whole are left out, as they may be large.
+func elements[A, B any](f *Failure, exp []A, val []B, eq func(A, B) bool) {
+	f.compared, f.exp, f.val = true, exp, val
+
+	script := edits(exp, val, eq)
+
+	var deleted, inserted, changed []string
+	for k := 0; k < len(script); {
+		if script[k].op == opEqual {
+			k++
+			continue
+		}
+		var dels, ins []edit
+		for ; k < len(script) && script[k].op == opDelete; k++ {
+			dels = append(dels, script[k])
+		}
+		for ; k < len(script) && script[k].op == opInsert; k++ {
+			ins = append(ins, script[k])
+		}
+		n := min(len(dels), len(ins))
+		for x := 0; x < n; x++ {
+			changed = append(changed, index(dels[x].i, ins[x].j))
+		}
+		for _, e := range dels[n:] {
+			deleted = append(deleted, fmt.Sprintf("exp[%d]", e.i))
+		}
+		for _, e := range ins[n:] {
+			inserted = append(inserted, fmt.Sprintf("val[%d]", e.j))
+		}
+	}
+	indexes(f, "changed", changed, maxIndexes)
+	indexes(f, "deleted", deleted, maxIndexes)
+	indexes(f, "inserted", inserted, maxIndexes)
+
+	s := new(strings.Builder)
+	s.WriteString("--- exp\n")
+	s.WriteString("+++ val\n")
+	for k := 0; k < len(script); {
+		e := script[k]
+		if e.op != opEqual {
+			switch e.op {
+			case opDelete:
+				fmt.Fprintf(s, "- [%d] %#v\n", e.i, exp[e.i])
+			case opInsert:
+				fmt.Fprintf(s, "+ [%d] %#v\n", e.j, val[e.j])
+			}
+			k++
+			continue
+		}
+
+		// a run of equal elements is elided beyond the context of the changes
+		// on either side of it
+		end := k
+		for end < len(script) && script[end].op == opEqual {
+			end++
+		}
+		lead, trail := contextLines, contextLines
+		if k == 0 {
+			lead = 0
+		}
+		if end == len(script) {
+			trail = 0
+		}
+		if end-k <= lead+trail {
+			lead, trail = end-k, 0
+		}
+		for _, e := range script[k : k+lead] {
+			fmt.Fprintf(s, "  [%d] %#v\n", e.i, exp[e.i])
+		}
+		if elided := end - k - lead - trail; elided > 0 {
+			fmt.Fprintf(s, "@@ %d equal %s @@\n", elided, plural(elided, "element"))
+		}
+		for _, e := range script[end-trail : end] {
+			fmt.Fprintf(s, "  [%d] %#v\n", e.i, exp[e.i])
+		}
+		k = end
+	}
+	f.Diff = s.String()
+}
+
+// index renders the indexes of a changed element, which may differ when
+// elements were deleted or inserted before it.
+func index(i, j int) string {
+	if i == j {
+		return fmt.Sprintf("[%d]", i)
+	}
+	return fmt.Sprintf("exp[%d] → val[%d]", i, j)
+}
+
+// indexes records a bullet listing at most limit indexes of the elements of a
+// kind of change, if any.
+func indexes(f *Failure, label string, list []string, limit int) {
+	switch n := len(list); {
+	case n == 0:
+		return
+	case n > limit:
+		list = append(list[:limit:limit], fmt.Sprintf("… %d more", n-limit))
+	}
+	f.bullet("%s: %s\n", label, strings.Join(list, ", "))
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return noun
+	}
+	return noun + "s"
+}
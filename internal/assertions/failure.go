@@ -0,0 +1,198 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// A PostScript is used to annotate a Failure with additional information.
+type PostScript interface {
+	// Label should categorize what is in Content.
+	Label() string
+
+	// Content contains extra contextual information for debugging a test failure.
+	Content() string
+}
+
+// A Failure describes why an assertion failed. Assertions produce a Failure
+// as data, which is rendered into output only when it is reported.
+type Failure struct {
+	// Kind is the name of the assertion that failed, e.g. "Eq".
+	Kind string
+
+	// Message summarizes why the assertion failed.
+	Message string
+
+	// Expected is the Go syntax representation of the expected value, if the
+	// assertion compares two values as a whole rather than element-wise.
+	Expected string
+
+	// Actual is the Go syntax representation of the actual value, if the
+	// assertion compares two values as a whole rather than element-wise.
+	Actual string
+
+	// Diff is the difference between the expected and actual values, if the
+	// assertion compares two values and a difference could be computed.
+	Diff string
+
+	// Sources contain the source code expressions given as arguments of the
+	// assertion, if they could be determined.
+	Sources []Source
+
+	// Bullets contain additional details about the failure.
+	Bullets []string
+
+	// File is the name of the file containing the assertion.
+	File string
+
+	// Line is the line number of the assertion in File.
+	Line int
+
+	// PostScripts contain additional context given to the assertion.
+	PostScripts []PostScript
+
+	comparison bool // cmp.Diff was not possible
+	compared   bool // exp and val are set
+	exp, val   any
+	color      *bool // overrides colorize
+}
+
+func failure(msg string, args ...any) *Failure {
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+	return &Failure{Message: strings.TrimSpace(msg)}
+}
+
+func (f *Failure) bullet(msg string, args ...any) {
+	f.Bullets = append(f.Bullets, strings.TrimRight(fmt.Sprintf(msg, args...), "\n"))
+}
+
+// block records s as a bullet with the given label, placing s on numbered lines
+// of its own if s spans multiple lines.
+func (f *Failure) block(label, s string) {
+	if !multiline(s) {
+		f.bullet("%s: %s\n", label, s)
+		return
+	}
+	f.bullet("%s ↷\n%s", strings.TrimSpace(label), numbered(s))
+}
+
+// diff records the difference of a and b using cmp.Diff if possible, falling
+// back to recording only the Go string values of both (e.g. contains unexported
+// fields). Multi-line strings are compared line by line.
+func (f *Failure) diff(a, b any, opts cmp.Options) {
+	f.Expected = fmt.Sprintf("%#v", a)
+	f.Actual = fmt.Sprintf("%#v", b)
+	f.compared, f.exp, f.val = true, a, b
+	if multiline(a) || multiline(b) {
+		f.Lines()
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			f.comparison = true
+		}
+	}()
+	f.Diff = cmp.Diff(a, b, opts)
+}
+
+// Lines replaces the Diff of f with a line oriented unified diff of the values
+// being compared, if any. Strings and byte slices are compared by content, other
+// values by their Go syntax representation.
+func (f *Failure) Lines() {
+	if !f.compared {
+		return
+	}
+	f.comparison = false
+	f.Diff = unified("exp", "val", text(f.exp), text(f.val))
+}
+
+// text returns the content of v if v is a string or byte slice, otherwise the
+// Go syntax representation of v.
+func text(v any) string {
+	if s, ok := content(v); ok {
+		return s
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// content returns the content of v if v is a string or byte slice.
+func content(v any) (string, bool) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case !rv.IsValid():
+		return "", false
+	case rv.Kind() == reflect.String:
+		return rv.String(), true
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return string(rv.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+// multiline reports whether v is a string or byte slice spanning many lines.
+func multiline(v any) bool {
+	s, ok := content(v)
+	return ok && strings.Contains(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Caller returns the file:line prefix of the location of the assertion.
+func (f *Failure) Caller() string {
+	if f.File == "" {
+		return "[???]"
+	}
+	return fmt.Sprintf("%s:%d: ", f.File, f.Line)
+}
+
+// SetColor explicitly enables or disables colorized rendering of f, rather
+// than detecting whether output is to a terminal.
+func (f *Failure) SetColor(enabled bool) {
+	f.color = &enabled
+}
+
+func (f *Failure) colorful() bool {
+	if f.color != nil {
+		return *f.color
+	}
+	return colorize()
+}
+
+// Text renders the message, bullets, and value comparison of f as human
+// readable text.
+func (f *Failure) Text() string {
+	color := f.colorful()
+	s := new(strings.Builder)
+	s.WriteString(f.Message)
+	s.WriteString("\n")
+	for _, src := range f.Sources {
+		s.WriteString(paint(color, dim, "↪") + " " + src.Name + ": " + src.Expr + "\n")
+	}
+	for _, b := range f.Bullets {
+		s.WriteString(paint(color, dim, "↪") + " " + b + "\n")
+	}
+	switch {
+	case f.comparison:
+		s.WriteString(paint(color, dim, "↪ Assertion | comparison ↷") + "\n")
+		s.WriteString(paint(color, red, "a: "+f.Expected) + "\n")
+		s.WriteString(paint(color, green, "b: "+f.Actual) + "\n")
+	case f.Diff != "" || f.Expected != "" || f.Actual != "":
+		s.WriteString(paint(color, dim, "↪ Assertion | differential ↷") + "\n")
+		s.WriteString(paintDiff(color, f.Diff))
+	}
+	return strings.TrimSpace(s.String())
+}
+
+// String renders f as human readable text, including its location and any
+// post scripts.
+func (f *Failure) String() string {
+	s := f.Caller() + f.Text() + "\n" + postScripts(f.PostScripts, f.colorful())
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A goroutine is parsed from the output of runtime.Stack.
+type goroutine struct {
+	id    int
+	top   string // function at the top of the stack
+	trace string // stack trace, excluding the header
+}
+
+// goroutines returns the goroutines currently running, other than the one
+// calling goroutines.
+func goroutines() []goroutine {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	var result []goroutine
+	for i, block := range strings.Split(string(buf), "\n\n") {
+		if i == 0 {
+			continue // the stack of this goroutine is always first
+		}
+		header, trace, _ := strings.Cut(strings.TrimSpace(block), "\n")
+		// e.g. "goroutine 7 [chan receive]:"
+		fields := strings.Fields(header)
+		if len(fields) < 2 {
+			continue
+		}
+		id, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		top, _, _ := strings.Cut(trace, "\n")
+		if paren := strings.LastIndex(top, "("); paren > 0 {
+			top = top[:paren]
+		}
+		result = append(result, goroutine{id: id, top: top, trace: trace})
+	}
+	return result
+}
+
+// A LeakFilter decides which goroutines are not considered leaked.
+type LeakFilter struct {
+	TopFunctions []string
+	Stacks       []*regexp.Regexp
+}
+
+func (lf LeakFilter) ignore(g goroutine) bool {
+	if slices.Contains(lf.TopFunctions, g.top) {
+		return true
+	}
+	for _, re := range lf.Stacks {
+		if re.MatchString(g.trace) {
+			return true
+		}
+	}
+	return false
+}
+
+// NoGoroutineLeaks records the goroutines currently running, returning a
+// function which asserts no additional goroutines (except those matched by
+// filter) are still running, waiting up to timeout for them to finish.
+func NoGoroutineLeaks(filter LeakFilter, timeout time.Duration) func() *Failure {
+	here := Here()
+	before := make(map[int]bool)
+	for _, g := range goroutines() {
+		before[g.id] = true
+	}
+
+	return func() (f *Failure) {
+		// poll with a plain loop rather than through the wait package, whose
+		// timers and contexts would start goroutines of their own
+		var leaked []goroutine
+		deadline := time.Now().Add(timeout)
+		for {
+			leaked = leaked[:0]
+			for _, g := range goroutines() {
+				if !before[g.id] && !filter.ignore(g) {
+					leaked = append(leaked, g)
+				}
+			}
+			if len(leaked) == 0 {
+				return
+			}
+			if time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		f = failure("expected no leaked goroutines; found %d after %s\n", len(leaked), timeout)
+		f.At(here)
+
+		// group goroutines of identical stacks, ignoring argument values
+		// and program counter offsets
+		counts := make(map[string]int)
+		var order []string
+		for _, g := range leaked {
+			key := normalize(g.trace)
+			if counts[key] == 0 {
+				order = append(order, key)
+			}
+			counts[key]++
+		}
+		for _, key := range order {
+			f.bullet("%d × goroutine ↷\n%s\n", counts[key], key)
+		}
+		return
+	}
+}
+
+var (
+	argsRe   = regexp.MustCompile(`\(0x[^)]*\)$|\(\.\.\.\)$`)
+	offsetRe = regexp.MustCompile(` \+0x[0-9a-f]+$`)
+	idRe     = regexp.MustCompile(` in goroutine \d+$`)
+)
+
+// normalize the stack trace of a goroutine such that goroutines executing the
+// same code have the same trace.
+func normalize(trace string) string {
+	lines := strings.Split(trace, "\n")
+	for i, line := range lines {
+		line = argsRe.ReplaceAllString(line, "(...)")
+		line = offsetRe.ReplaceAllString(line, "")
+		line = idRe.ReplaceAllString(line, "")
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+func NoCleanup() (f *Failure) {
+	f = failure("expected T to implement Cleanup(func())\n")
+	return
+}
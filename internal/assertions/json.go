@@ -0,0 +1,77 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// JSONReporter renders each failure as a single line JSON object, suitable for
+// being parsed out of the Output of go test -json.
+type JSONReporter struct{}
+
+type jsonFailure struct {
+	File        string           `json:"file"`
+	Line        int              `json:"line"`
+	Assertion   string           `json:"assertion"`
+	Message     string           `json:"message"`
+	Expected    string           `json:"expected,omitempty"`
+	Actual      string           `json:"actual,omitempty"`
+	Sources     []jsonSource     `json:"sources,omitempty"`
+	Diff        string           `json:"diff,omitempty"`
+	Bullets     []string         `json:"bullets,omitempty"`
+	PostScripts []jsonPostScript `json:"post_scripts,omitempty"`
+}
+
+type jsonSource struct {
+	Name string `json:"name"`
+	Expr string `json:"expr"`
+}
+
+type jsonPostScript struct {
+	Label   string `json:"label"`
+	Content string `json:"content"`
+}
+
+// Report renders failures as JSON, one object per line.
+func (JSONReporter) Report(failures []*Failure) string {
+	lines := make([]string, 0, len(failures))
+	for _, f := range failures {
+		lines = append(lines, f.JSON())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// JSON renders f as a single line JSON object.
+func (f *Failure) JSON() string {
+	jf := jsonFailure{
+		File:      f.File,
+		Line:      f.Line,
+		Assertion: f.Kind,
+		Message:   f.Message,
+		Expected:  f.Expected,
+		Actual:    f.Actual,
+		Diff:      f.Diff,
+		Bullets:   f.Bullets,
+	}
+	for _, src := range f.Sources {
+		jf.Sources = append(jf.Sources, jsonSource{Name: src.Name, Expr: src.Expr})
+	}
+	for _, post := range f.PostScripts {
+		jf.PostScripts = append(jf.PostScripts, jsonPostScript{
+			Label:   post.Label(),
+			Content: post.Content(),
+		})
+	}
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(jf); err != nil {
+		// no field of jsonFailure can fail to encode
+		panic(err)
+	}
+	return strings.TrimSpace(buf.String())
+}
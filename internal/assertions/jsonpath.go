@@ -0,0 +1,216 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// unmarshal decodes doc as generic JSON, i.e. objects as map[string]any and
+// arrays as []any.
+func unmarshal(doc string) (any, error) {
+	var v any
+	err := json.Unmarshal([]byte(doc), &v)
+	return v, err
+}
+
+// compact renders the generic JSON value v on a single line.
+func compact(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%#v", v)
+	}
+	return string(b)
+}
+
+// indent renders the generic JSON value v across indented lines.
+func indent(v any) string {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%#v", v)
+	}
+	return string(b)
+}
+
+func JSONPath(doc, path string, exp any) (f *Failure) {
+	root, err := unmarshal(doc)
+	if err != nil {
+		f = failure("failed to unmarshal document as JSON: %v\n", err)
+		return
+	}
+
+	// the expected value is compared as it would be decoded from JSON, so that
+	// e.g. an int matches a number
+	encoded, err := json.Marshal(exp)
+	if err != nil {
+		f = failure("failed to marshal expected value as JSON: %v\n", err)
+		return
+	}
+	want, _ := unmarshal(string(encoded))
+
+	at, err := navigate(reflect.ValueOf(root), strings.TrimPrefix(path, "$"), "$")
+	if err != nil {
+		f = failure("expected JSON path to exist\n")
+		f.bullet("path: %s\n", path)
+		f.bullet("error: %v\n", err)
+		return
+	}
+	var got any
+	if at.IsValid() {
+		got = at.Interface()
+	}
+
+	if !reflect.DeepEqual(want, got) {
+		f = failure("expected equality at JSON path\n")
+		f.bullet("path: %s\n", path)
+		f.diff(indent(want), indent(got), nil)
+	}
+	return
+}
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// member returns the path of key of the object at path.
+func member(path, key string) string {
+	if identifierRe.MatchString(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s[%q]", path, key)
+}
+
+// subset accumulates the mismatches of a generic JSON value compared to one
+// expected to be a subset of it.
+type subset struct {
+	unordered  bool
+	mismatches []mismatch
+}
+
+func (s *subset) mismatch(path, reason string, args ...any) {
+	s.mismatches = append(s.mismatches, mismatch{
+		path:   path,
+		reason: fmt.Sprintf(reason, args...),
+	})
+}
+
+// match compares exp to val, where each member of an object of exp must match
+// the member of the same key of val, and each element of an array of exp must
+// match the element of the same index of val (or any one element of val, if
+// the order of arrays is ignored).
+func (s *subset) match(path string, exp, val any) {
+	switch e := exp.(type) {
+	case map[string]any:
+		v, ok := val.(map[string]any)
+		if !ok {
+			s.mismatch(path, "exp object, val %s", compact(val))
+			return
+		}
+		keys := make([]string, 0, len(e))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			elem, exists := v[key]
+			if !exists {
+				s.mismatch(member(path, key), "missing from val")
+				continue
+			}
+			s.match(member(path, key), e[key], elem)
+		}
+	case []any:
+		v, ok := val.([]any)
+		if !ok {
+			s.mismatch(path, "exp array, val %s", compact(val))
+			return
+		}
+		if len(e) != len(v) {
+			s.mismatch(path, "exp %d elements, val %d elements", len(e), len(v))
+			return
+		}
+		if s.unordered {
+			s.anyOrder(path, e, v)
+			return
+		}
+		for i := range e {
+			s.match(fmt.Sprintf("%s[%d]", path, i), e[i], v[i])
+		}
+	default:
+		if !reflect.DeepEqual(exp, val) {
+			s.mismatch(path, "exp %s, val %s", compact(exp), compact(val))
+		}
+	}
+}
+
+// anyOrder matches each element of exp to a distinct element of val, by way of
+// augmenting paths through the elements of val each element of exp matches.
+func (s *subset) anyOrder(path string, exp, val []any) {
+	matches := make([][]bool, len(exp))
+	for i := range exp {
+		matches[i] = make([]bool, len(val))
+		for j := range val {
+			trial := &subset{unordered: s.unordered}
+			trial.match("", exp[i], val[j])
+			matches[i][j] = len(trial.mismatches) == 0
+		}
+	}
+
+	owner := make([]int, len(val)) // index of exp matched to each val
+	for j := range owner {
+		owner[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j := range val {
+			if seen[j] || !matches[i][j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] < 0 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range exp {
+		if !augment(i, make([]bool, len(val))) {
+			s.mismatch(fmt.Sprintf("%s[%d]", path, i), "no matching element in val for %s", compact(exp[i]))
+		}
+	}
+}
+
+func JSONContains(doc, sub string, unordered bool, limit int) (f *Failure) {
+	val, err := unmarshal(doc)
+	if err != nil {
+		f = failure("failed to unmarshal document as JSON: %v\n", err)
+		return
+	}
+	exp, err := unmarshal(sub)
+	if err != nil {
+		f = failure("failed to unmarshal subset as JSON: %v\n", err)
+		return
+	}
+
+	s := &subset{unordered: unordered}
+	s.match("$", exp, val)
+	if len(s.mismatches) == 0 {
+		return nil
+	}
+
+	f = failure("expected JSON to contain subset\n")
+	for i, m := range s.mismatches {
+		if i == limit {
+			f.bullet("… %d more\n", len(s.mismatches)-limit)
+			break
+		}
+		f.bullet("%s: %s\n", m.path, m.reason)
+	}
+	return f
+}
@@ -0,0 +1,168 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// mapEq compares the maps exp and val, producing a Failure listing every
+// missing key, extra key, and key of differing values as determined by eq. At
+// most limit differences are rendered, in order of their keys; diff renders the
+// difference of the values of a key, if possible.
+func mapEq[K comparable, V any](exp, val map[K]V, method string, eq func(V, V) bool, diff func(V, V) string, limit int) (f *Failure) {
+	var missing, extra, changed []K
+	for key, a := range exp {
+		b, exists := val[key]
+		switch {
+		case !exists:
+			missing = append(missing, key)
+		case !eq(a, b):
+			changed = append(changed, key)
+		}
+	}
+	for key := range val {
+		if _, exists := exp[key]; !exists {
+			extra = append(extra, key)
+		}
+	}
+	if len(missing)+len(extra)+len(changed) == 0 {
+		return nil
+	}
+
+	lenA, lenB := len(exp), len(val)
+	switch {
+	case lenA != lenB:
+		f = failure("expected maps of same length\n")
+		f.bullet("len(exp): %d\n", lenA)
+		f.bullet("len(val): %d\n", lenB)
+	case len(missing)+len(extra) > 0:
+		f = failure("expected maps of same keys\n")
+	default:
+		f = failure("expected maps of same values via %s\n", method)
+	}
+
+	sortKeys(missing)
+	sortKeys(extra)
+	sortKeys(changed)
+	indexes(f, "missing keys", render(missing), limit)
+	indexes(f, "extra keys", render(extra), limit)
+	indexes(f, "different values", render(changed), limit)
+
+	f.Expected = fmt.Sprintf("%#v", exp)
+	f.Actual = fmt.Sprintf("%#v", val)
+	f.compared, f.exp, f.val = true, exp, val
+
+	all := slices.Concat(missing, extra, changed)
+	sortKeys(all)
+	s := new(strings.Builder)
+	s.WriteString("--- exp\n")
+	s.WriteString("+++ val\n")
+	for i, key := range all {
+		if i == limit {
+			more := len(all) - limit
+			fmt.Fprintf(s, "@@ %d more %s @@\n", more, plural(more, "difference"))
+			break
+		}
+		a, inA := exp[key]
+		b, inB := val[key]
+		switch {
+		case !inB:
+			fmt.Fprintf(s, "- %#v: %#v\n", key, a)
+		case !inA:
+			fmt.Fprintf(s, "+ %#v: %#v\n", key, b)
+		default:
+			if d := diff(a, b); d != "" {
+				fmt.Fprintf(s, "@@ %#v @@\n%s", key, d)
+				continue
+			}
+			fmt.Fprintf(s, "- %#v: %#v\n", key, a)
+			fmt.Fprintf(s, "+ %#v: %#v\n", key, b)
+		}
+	}
+	f.Diff = s.String()
+	return f
+}
+
+// cmpDiff returns a function rendering the difference of two composite values
+// using cmp.Diff if possible, or nothing if not (e.g. contains unexported fields).
+func cmpDiff[V any](opts cmp.Options) func(V, V) string {
+	return func(a, b V) (s string) {
+		switch reflect.ValueOf(a).Kind() {
+		case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Pointer:
+		default:
+			return ""
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				s = ""
+			}
+		}()
+		return cmp.Diff(a, b, opts)
+	}
+}
+
+// noDiff renders the difference of two values as nothing, for when they are
+// compared by a means other than cmp.Equal.
+func noDiff[V any](V, V) string {
+	return ""
+}
+
+// sortKeys sorts keys by their natural order if they are of an ordered kind,
+// otherwise by their Go syntax representation.
+func sortKeys[K comparable](keys []K) {
+	slices.SortFunc(keys, func(a, b K) int {
+		return compareKeys(reflect.ValueOf(a), reflect.ValueOf(b))
+	})
+}
+
+// compareKeys orders x and y by their natural order if they are of the same
+// ordered kind, otherwise by their Go syntax representation.
+func compareKeys(x, y reflect.Value) int {
+	if x.Kind() == reflect.Interface {
+		x = x.Elem()
+	}
+	if y.Kind() == reflect.Interface {
+		y = y.Elem()
+	}
+	if x.Kind() != y.Kind() {
+		return order(fmt.Sprintf("%#v", x), fmt.Sprintf("%#v", y))
+	}
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return order(x.Int(), y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return order(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return order(x.Float(), y.Float())
+	case reflect.String:
+		return order(x.String(), y.String())
+	default:
+		return order(fmt.Sprintf("%#v", x), fmt.Sprintf("%#v", y))
+	}
+}
+
+func order[O int64 | uint64 | float64 | string](a, b O) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func render[K any](keys []K) []string {
+	list := make([]string, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, fmt.Sprintf("%#v", key))
+	}
+	return list
+}
@@ -0,0 +1,304 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"strings"
+)
+
+type op int
+
+const (
+	opEqual op = iota
+	opDelete
+	opInsert
+)
+
+// An edit is one step of transforming a into b; i and j are the indexes into
+// a and b of the element the edit applies to.
+type edit struct {
+	op op
+	i  int
+	j  int
+}
+
+// edits computes the shortest sequence of edits transforming a into b, by way
+// of the longest common subsequence of a and b as determined by eq.
+//
+// The subsequence is found using the linear space variant of the Myers O(ND)
+// difference algorithm, such that memory is proportional to len(a)+len(b)
+// rather than their product.
+func edits[A, B any](a []A, b []B, eq func(A, B) bool) []edit {
+	d := &differ[A, B]{
+		a:        a,
+		b:        b,
+		eq:       eq,
+		deleted:  make([]bool, len(a)),
+		inserted: make([]bool, len(b)),
+	}
+	d.compare(0, len(a), 0, len(b))
+
+	// elements of a and b not marked as changed form the common subsequence,
+	// in order, so the remaining elements of each are equal pairwise
+	result := make([]edit, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && d.deleted[i]:
+			result = append(result, edit{op: opDelete, i: i, j: j})
+			i++
+		case j < len(b) && d.inserted[j]:
+			result = append(result, edit{op: opInsert, i: i, j: j})
+			j++
+		default:
+			result = append(result, edit{op: opEqual, i: i, j: j})
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// maxCost bounds the number of differences searched for when splitting a and b,
+// beyond which the remaining elements are considered entirely changed; this
+// keeps the time taken to compare large and very different inputs reasonable,
+// at the expense of a longer sequence of edits.
+const maxCost = 1024
+
+// A differ marks the elements of a deleted and of b inserted in transforming a
+// into b.
+type differ[A, B any] struct {
+	a        []A
+	b        []B
+	eq       func(A, B) bool
+	deleted  []bool
+	inserted []bool
+}
+
+// compare marks the changed elements of a[aLo:aHi] and b[bLo:bHi].
+func (d *differ[A, B]) compare(aLo, aHi, bLo, bHi int) {
+	// common prefix and suffix need not take part in the search
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aLo], d.b[bLo]) {
+		aLo++
+		bLo++
+	}
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aHi-1], d.b[bHi-1]) {
+		aHi--
+		bHi--
+	}
+
+	if aLo == aHi || bLo == bHi {
+		d.change(aLo, aHi, bLo, bHi)
+		return
+	}
+
+	x, y, ok := d.split(aLo, aHi, bLo, bHi)
+	if !ok || (x == aLo && y == bLo) || (x == aHi && y == bHi) {
+		d.change(aLo, aHi, bLo, bHi)
+		return
+	}
+	d.compare(aLo, x, bLo, y)
+	d.compare(x, aHi, y, bHi)
+}
+
+// change marks every element of a[aLo:aHi] and b[bLo:bHi] as changed.
+func (d *differ[A, B]) change(aLo, aHi, bLo, bHi int) {
+	for i := aLo; i < aHi; i++ {
+		d.deleted[i] = true
+	}
+	for j := bLo; j < bHi; j++ {
+		d.inserted[j] = true
+	}
+}
+
+// split finds the middle snake of a shortest edit path from (aLo, bLo) to
+// (aHi, bHi), returning the point at which to divide the comparison. Reports
+// false if no such point was found within maxCost differences.
+func (d *differ[A, B]) split(aLo, aHi, bLo, bHi int) (int, int, bool) {
+	n, m := aHi-aLo, bHi-bLo
+	limit := min((n+m+1)/2, maxCost)
+	offset := limit + 1
+	forward := make([]int, 2*limit+3)
+	backward := make([]int, 2*limit+3)
+	for k := range forward {
+		forward[k], backward[k] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	odd := delta%2 != 0
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0 // diagonals beyond the edges
+
+	for cost := 0; cost < limit; cost++ {
+		// extend the furthest reaching paths from the start
+		for k := -cost + fStart; k <= cost-fEnd; k += 2 {
+			var x int
+			if k == -cost || (k != cost && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.eq(d.a[aLo+x], d.b[bLo+y]) {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				rk := offset + delta - k
+				if rk >= 0 && rk < len(backward) && backward[rk] != -1 && x >= n-backward[rk] {
+					return aLo + x, bLo + y, true
+				}
+			}
+		}
+
+		// extend the furthest reaching paths from the end
+		for k := -cost + bStart; k <= cost-bEnd; k += 2 {
+			var x int
+			if k == -cost || (k != cost && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			} else {
+				x = backward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.eq(d.a[aHi-1-x], d.b[bHi-1-y]) {
+				x++
+				y++
+			}
+			backward[offset+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				fk := offset + delta - k
+				if fk >= 0 && fk < len(forward) && forward[fk] != -1 {
+					fx := forward[fk]
+					fy := fx - (fk - offset)
+					if fx >= n-x {
+						return aLo + fx, bLo + fy, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// contextLines is the number of unchanged lines surrounding each hunk of a
+// unified diff.
+const contextLines = 3
+
+// unified renders the line differences of a and b in unified diff format,
+// labeling each side with the names of a and b.
+func unified(nameA, nameB, a, b string) string {
+	// a missing final newline is only notable when the other side has one
+	if !strings.HasSuffix(a, "\n") && !strings.HasSuffix(b, "\n") {
+		a, b = a+"\n", b+"\n"
+	}
+	linesA, linesB := lines(a), lines(b)
+	script := edits(linesA, linesB, func(x, y string) bool { return x == y })
+
+	s := new(strings.Builder)
+	fmt.Fprintf(s, "--- %s\n", nameA)
+	fmt.Fprintf(s, "+++ %s\n", nameB)
+	for start := 0; start < len(script); {
+		// find the next change
+		for start < len(script) && script[start].op == opEqual {
+			start++
+		}
+		if start == len(script) {
+			break
+		}
+
+		// extend the hunk until there is a gap of unchanged lines too large
+		// to be covered by context
+		first := max(0, start-contextLines)
+		end := start
+		for end < len(script) {
+			if script[end].op != opEqual {
+				end++
+				continue
+			}
+			gap := end
+			for gap < len(script) && script[gap].op == opEqual {
+				gap++
+			}
+			if gap == len(script) || gap-end > 2*contextLines {
+				end = min(end+contextLines, len(script))
+				break
+			}
+			end = gap
+		}
+		hunk := script[first:end]
+
+		countA, countB := 0, 0
+		for _, e := range hunk {
+			if e.op != opInsert {
+				countA++
+			}
+			if e.op != opDelete {
+				countB++
+			}
+		}
+		fmt.Fprintf(s, "@@ -%s +%s @@\n", span(hunk[0].i, countA), span(hunk[0].j, countB))
+		for _, e := range hunk {
+			switch e.op {
+			case opEqual:
+				line(s, " ", linesA[e.i])
+			case opDelete:
+				line(s, "-", linesA[e.i])
+			case opInsert:
+				line(s, "+", linesB[e.j])
+			}
+		}
+		start = end
+	}
+	return s.String()
+}
+
+// lines splits s into lines, each retaining its trailing newline (if any).
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	result := strings.SplitAfter(s, "\n")
+	if result[len(result)-1] == "" {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+func span(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func line(s *strings.Builder, prefix, text string) {
+	s.WriteString(prefix)
+	s.WriteString(text)
+	if !strings.HasSuffix(text, "\n") {
+		s.WriteString("\n\\ No newline at end of file\n")
+	}
+}
+
+// numbered prefixes each line of s with its line number.
+func numbered(s string) string {
+	all := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	width := len(fmt.Sprint(len(all)))
+	b := new(strings.Builder)
+	for i, l := range all {
+		fmt.Fprintf(b, "%*d | %s\n", width, i+1, l)
+	}
+	return b.String()
+}
@@ -0,0 +1,158 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// A mismatch is a difference between exp and val found at path.
+type mismatch struct {
+	path   string
+	reason string
+}
+
+// A visit is of a pair of references compared, which are not compared again so
+// as to terminate on cyclic values, as with reflect.DeepEqual.
+type visit struct {
+	exp, val uintptr
+	typ      reflect.Type
+}
+
+// partial accumulates the mismatches of values compared partially.
+type partial struct {
+	opts       cmp.Options
+	mismatches []mismatch
+	visited    map[visit]bool
+}
+
+func (p *partial) mismatch(path, reason string, args ...any) {
+	p.mismatches = append(p.mismatches, mismatch{
+		path:   display(path),
+		reason: fmt.Sprintf(reason, args...),
+	})
+}
+
+// leaf compares exp and val as a whole.
+func (p *partial) leaf(path string, exp, val reflect.Value) {
+	a, b := exp.Interface(), val.Interface()
+	if !equal(a, b, p.opts) {
+		p.mismatch(path, "exp %#v, val %#v", a, b)
+	}
+}
+
+// match compares the non-zero parts of exp to those of val, recursing into
+// pointers, interfaces, structs, slices, arrays, and maps.
+func (p *partial) match(path string, exp, val reflect.Value) {
+	if exp.IsZero() {
+		return
+	}
+	if exp.Type() != val.Type() {
+		p.mismatch(path, "exp %#v, val %#v", exp.Interface(), val.Interface())
+		return
+	}
+
+	switch exp.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		v := visit{exp: exp.Pointer(), val: val.Pointer(), typ: exp.Type()}
+		if p.visited[v] {
+			return
+		}
+		p.visited[v] = true
+	}
+
+	switch exp.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if val.IsNil() {
+			p.mismatch(path, "exp %#v, val nil", exp.Elem().Interface())
+			return
+		}
+		p.match(path, exp.Elem(), val.Elem())
+	case reflect.Struct:
+		if opaque(exp.Type()) {
+			p.leaf(path, exp, val)
+			return
+		}
+		for i := 0; i < exp.NumField(); i++ {
+			field := exp.Type().Field(i)
+			p.match(path+"."+field.Name, exp.Field(i), val.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if exp.Len() != val.Len() {
+			p.mismatch(path, "exp len(%d), val len(%d)", exp.Len(), val.Len())
+			return
+		}
+		for i := 0; i < exp.Len(); i++ {
+			p.match(fmt.Sprintf("%s[%d]", path, i), exp.Index(i), val.Index(i))
+		}
+	case reflect.Map:
+		keys := exp.MapKeys()
+		slices.SortFunc(keys, compareKeys)
+		for _, key := range keys {
+			elem := val.MapIndex(key)
+			if !elem.IsValid() {
+				p.mismatch(path+keyStep(key), "missing from val")
+				continue
+			}
+			p.match(path+keyStep(key), exp.MapIndex(key), elem)
+		}
+	default:
+		p.leaf(path, exp, val)
+	}
+}
+
+// opaque reports whether values of struct type t must be compared as a whole,
+// i.e. t has unexported fields (e.g. time.Time).
+func opaque(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// paths compares the values of exp and val at each of paths as a whole.
+func (p *partial) paths(exp, val reflect.Value, paths []string) {
+	for _, path := range paths {
+		a, err := navigate(exp, path, "")
+		if err != nil {
+			p.mismatch(path, "not in exp: %v", err)
+			continue
+		}
+		b, err := navigate(val, path, "")
+		if err != nil {
+			p.mismatch(path, "not in val: %v", err)
+			continue
+		}
+		p.leaf(path, a, b)
+	}
+}
+
+func EqPartial[A any](exp, val A, paths []string, opts cmp.Options, limit int) (f *Failure) {
+	p := &partial{opts: opts, visited: make(map[visit]bool)}
+	a, b := reflect.ValueOf(&exp).Elem(), reflect.ValueOf(&val).Elem()
+	if len(paths) > 0 {
+		p.paths(a, b, paths)
+	} else {
+		p.match("", a, b)
+	}
+	if len(p.mismatches) == 0 {
+		return nil
+	}
+
+	f = failure("expected partial equality via cmp.Equal function\n")
+	for i, m := range p.mismatches {
+		if i == limit {
+			f.bullet("… %d more\n", len(p.mismatches)-limit)
+			break
+		}
+		f.bullet("%s: %s\n", m.path, m.reason)
+	}
+	return f
+}
@@ -0,0 +1,251 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// A step of a path selects a field of a struct (or a string key of a map), or
+// an index of a slice or array (or a key of a map).
+type step struct {
+	field   string
+	bracket bool
+}
+
+func (s step) String() string {
+	if !s.bracket {
+		return "." + s.field
+	}
+	if _, err := strconv.Atoi(s.field); err == nil {
+		return "[" + s.field + "]"
+	}
+	return fmt.Sprintf("[%q]", s.field)
+}
+
+// parsePath parses a path made of steps like ".Spec", "[2]", and `["app"]`,
+// e.g. ".Spec.Containers[2].Image". The leading dot may be omitted.
+func parsePath(path string) ([]step, error) {
+	var steps []step
+	rest := path
+	if rest != "" && rest[0] != '.' && rest[0] != '[' {
+		rest = "." + rest
+	}
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path %q: missing field name", path)
+			}
+			steps = append(steps, step{field: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			rest = rest[1:]
+			var key string
+			if strings.HasPrefix(rest, `"`) {
+				quoted, err := strconv.QuotedPrefix(rest)
+				if err != nil {
+					return nil, fmt.Errorf("path %q: malformed key: %w", path, err)
+				}
+				key, _ = strconv.Unquote(quoted)
+				rest = rest[len(quoted):]
+			} else {
+				end := strings.Index(rest, "]")
+				if end < 0 {
+					end = len(rest)
+				}
+				key = rest[:end]
+				rest = rest[end:]
+			}
+			if !strings.HasPrefix(rest, "]") {
+				return nil, fmt.Errorf("path %q: missing closing bracket", path)
+			}
+			rest = rest[1:]
+			steps = append(steps, step{field: key, bracket: true})
+		default:
+			return nil, fmt.Errorf("path %q: expected '.' or '[' at %q", path, rest)
+		}
+	}
+	return steps, nil
+}
+
+// navigate follows path from v, dereferencing any pointers and interfaces on
+// the way, returning the value at the end of path. Errors refer to the steps
+// taken as a path starting from root.
+func navigate(v reflect.Value, path, root string) (reflect.Value, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	at := root
+	for _, s := range steps {
+		if v, err = deref(v, at); err != nil {
+			return reflect.Value{}, err
+		}
+		if v, err = follow(v, s, at); err != nil {
+			return reflect.Value{}, err
+		}
+		at += s.String()
+	}
+	return v, nil
+}
+
+// deref follows pointers and interfaces of v at path until reaching a value.
+func deref(v reflect.Value, at string) (reflect.Value, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, fmt.Errorf("%s: is nil", display(at))
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return reflect.Value{}, fmt.Errorf("%s: is nil", display(at))
+	}
+	return v, nil
+}
+
+// follow takes step s from v at path.
+func follow(v reflect.Value, s step, at string) (reflect.Value, error) {
+	here := display(at + s.String())
+	switch {
+	case v.Kind() == reflect.Struct && !s.bracket:
+		field, ok := v.Type().FieldByName(s.field)
+		if !ok || !field.IsExported() {
+			return reflect.Value{}, fmt.Errorf("%s: no exported field %q in %s", here, s.field, v.Type())
+		}
+		return v.FieldByIndex(field.Index), nil
+	case v.Kind() == reflect.Map:
+		if !s.bracket && v.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, fmt.Errorf("%s: cannot select field of %s", here, v.Type())
+		}
+		key, err := mapKey(v.Type().Key(), s.field)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: %w", here, err)
+		}
+		elem := v.MapIndex(key)
+		if !elem.IsValid() {
+			return reflect.Value{}, fmt.Errorf("%s: no such key", here)
+		}
+		return elem, nil
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && s.bracket:
+		i, err := strconv.Atoi(s.field)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: invalid index %q", here, s.field)
+		}
+		if i < 0 || i >= v.Len() {
+			return reflect.Value{}, fmt.Errorf("%s: index out of range (len %d)", here, v.Len())
+		}
+		return v.Index(i), nil
+	case s.bracket:
+		return reflect.Value{}, fmt.Errorf("%s: cannot index %s", here, v.Type())
+	default:
+		return reflect.Value{}, fmt.Errorf("%s: cannot select field of %s", here, v.Type())
+	}
+}
+
+// mapKey converts s into a key of type t.
+func mapKey(t reflect.Type, s string) (reflect.Value, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(s).Convert(t), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid key %q for %s", s, t)
+		}
+		return reflect.ValueOf(i).Convert(t), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid key %q for %s", s, t)
+		}
+		return reflect.ValueOf(u).Convert(t), nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid key %q for %s", s, t)
+		}
+		return reflect.ValueOf(b).Convert(t), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported key type %s", t)
+	}
+}
+
+// keyStep returns the step selecting key of a map.
+func keyStep(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return fmt.Sprintf("[%q]", key.String())
+	}
+	return fmt.Sprintf("[%v]", key.Interface())
+}
+
+// display renders path, where the empty path is the root value.
+func display(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+func Path[A any](v any, path string, exp A, opts cmp.Options) (f *Failure) {
+	at, err := navigate(reflect.ValueOf(v), path, "")
+	if err != nil {
+		f = failure("expected path to exist\n")
+		f.bullet("path: %s\n", path)
+		f.bullet("error: %v\n", err)
+		return
+	}
+	var val A
+	target := reflect.ValueOf(&val).Elem()
+	if at.Kind() == reflect.Interface && target.Kind() != reflect.Interface && !at.IsNil() {
+		at = at.Elem()
+	}
+	if !at.IsValid() || !at.Type().AssignableTo(target.Type()) {
+		f = failure("expected value at path of type %s\n", target.Type())
+		f.bullet("path: %s\n", path)
+		if at.IsValid() {
+			f.bullet("type: %s\n", at.Type())
+		}
+		return
+	}
+	target.Set(at)
+	if !equal(exp, val, opts) {
+		f = failure("expected equality at path via cmp.Equal function\n")
+		f.bullet("path: %s\n", path)
+		f.diff(exp, val, opts)
+	}
+	return
+}
+
+func PathExists(v any, path string) (f *Failure) {
+	if _, err := navigate(reflect.ValueOf(v), path, ""); err != nil {
+		f = failure("expected path to exist\n")
+		f.bullet("path: %s\n", path)
+		f.bullet("error: %v\n", err)
+	}
+	return
+}
+
+func PathNotExists(v any, path string) (f *Failure) {
+	if _, err := parsePath(path); err != nil {
+		f = failure("expected valid path\n")
+		f.bullet("error: %v\n", err)
+		return
+	}
+	if _, err := navigate(reflect.ValueOf(v), path, ""); err == nil {
+		f = failure("expected path to not exist\n")
+		f.bullet("path: %s\n", path)
+	}
+	return
+}
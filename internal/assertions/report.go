@@ -0,0 +1,109 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// A Reporter renders failures into the content logged by a test case.
+type Reporter interface {
+	Report(failures []*Failure) string
+}
+
+// TextReporter renders failures as human readable text.
+type TextReporter struct{}
+
+// Report renders failures as text. Multiple failures (as collected by a group)
+// are rendered as one numbered summary.
+func (r TextReporter) Report(failures []*Failure) string {
+	if len(failures) == 1 {
+		return failures[0].String()
+	}
+	return r.ReportGroup(failures)
+}
+
+// ReportGroup renders the failures collected by a group as one numbered summary,
+// even if there is only one.
+func (TextReporter) ReportGroup(failures []*Failure) string {
+	s := new(strings.Builder)
+	if len(failures) == 1 {
+		s.WriteString("1 assertion failed in group\n")
+	} else {
+		fmt.Fprintf(s, "%d assertions failed in group\n", len(failures))
+	}
+	for i, f := range failures {
+		fmt.Fprintf(s, "[%d] %s\n", i+1, f.String())
+	}
+	return strings.TrimSpace(s.String())
+}
+
+// A groupReporter is a Reporter which renders the failures collected by a group
+// differently from those of assertions made directly.
+type groupReporter interface {
+	ReportGroup(failures []*Failure) string
+}
+
+// OutputEnvVar is the environment variable used to select the default
+// Reporter. The value "json" selects the JSONReporter.
+const OutputEnvVar = "TEST_OUTPUT"
+
+var (
+	reporterLock sync.Mutex
+	reporter     = defaultReporter()
+)
+
+func defaultReporter() Reporter {
+	if strings.EqualFold(os.Getenv(OutputEnvVar), "json") {
+		return JSONReporter{}
+	}
+	return TextReporter{}
+}
+
+// SetReporter sets the Reporter used by Report, returning the previous Reporter.
+// Setting a nil Reporter restores the TextReporter.
+func SetReporter(r Reporter) Reporter {
+	if r == nil {
+		r = TextReporter{}
+	}
+	reporterLock.Lock()
+	defer reporterLock.Unlock()
+	previous := reporter
+	reporter = r
+	return previous
+}
+
+// Report renders failures using the current Reporter.
+func Report(failures ...*Failure) string {
+	reporterLock.Lock()
+	r := reporter
+	reporterLock.Unlock()
+	return strings.TrimSpace(r.Report(failures))
+}
+
+// ReportGroup renders the failures collected by a group using the current
+// Reporter, as a group summary if the Reporter renders one.
+func ReportGroup(failures ...*Failure) string {
+	reporterLock.Lock()
+	r := reporter
+	reporterLock.Unlock()
+	if g, ok := r.(groupReporter); ok {
+		return strings.TrimSpace(g.ReportGroup(failures))
+	}
+	return strings.TrimSpace(r.Report(failures))
+}
+
+func postScripts(posts []PostScript, color bool) string {
+	s := new(strings.Builder)
+	for _, post := range posts {
+		s.WriteString(paint(color, dim, "↪ PostScript | "+post.Label()+" ↷"))
+		s.WriteString("\n")
+		s.WriteString(post.Content())
+		s.WriteString("\n")
+	}
+	return s.String()
+}
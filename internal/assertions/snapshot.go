@@ -0,0 +1,166 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+func Snapshot(exp, val string, update bool) (f *Failure) {
+	exp = unframe(exp)
+	if exp == val {
+		return
+	}
+	if update {
+		frame, _, _, ok := caller()
+		if !ok {
+			f = failure("expected to locate inline snapshot\n")
+			return
+		}
+		if err := RewriteInline(frame.File, frame.Line, val); err != nil {
+			f = failure("expected to rewrite inline snapshot\n")
+			f.bullet(" name: %s\n", frame.File)
+			f.bullet("error: %s\n", err)
+		}
+		return
+	}
+	f = failure("expected value to match inline snapshot\n")
+	f.bullet("hint: run with -update or TEST_UPDATE_GOLDEN=1 to rewrite\n")
+	f.Expected = exp
+	f.Actual = val
+	f.Diff = unified("snapshot", "got", exp+"\n", val+"\n")
+	return
+}
+
+// unframe returns the content of an inline snapshot without the newlines framing
+// a raw string literal placed on lines of its own, as written by quote.
+func unframe(content string) string {
+	content = strings.TrimPrefix(content, "\n")
+	return strings.TrimSuffix(content, "\n")
+}
+
+// A shift records the change in the number of lines of a source file after
+// rewriting the inline snapshot of the call at line.
+type shift struct {
+	line  int
+	delta int
+}
+
+var (
+	rewriteLock sync.Mutex
+	shifts      = make(map[string][]shift)
+)
+
+// RewriteInline replaces the literal argument of the Inline call made within
+// the call expression at line of the Go source file with content.
+//
+// The line is as compiled into the test binary; any lines added or removed by
+// previous rewrites of the same file are accounted for.
+func RewriteInline(file string, line int, content string) error {
+	rewriteLock.Lock()
+	defer rewriteLock.Unlock()
+
+	current := line
+	for _, s := range shifts[file] {
+		if s.line < line {
+			current += s.delta
+		}
+	}
+
+	fset := token.NewFileSet()
+	root, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	var literal *ast.BasicLit
+	ast.Inspect(root, func(n ast.Node) bool {
+		if literal != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if fset.Position(call.Pos()).Line != current && fset.Position(call.Lparen).Line != current {
+			return true
+		}
+		for _, arg := range call.Args {
+			if lit := inlineArg(arg); lit != nil {
+				literal = lit
+				return false
+			}
+		}
+		return true
+	})
+	if literal == nil {
+		return fmt.Errorf("no inline snapshot at line %d", current)
+	}
+
+	before := strings.Count(literal.Value, "\n")
+	literal.Value = quote(content)
+	delta := strings.Count(literal.Value, "\n") - before
+
+	buf := new(bytes.Buffer)
+	cfg := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	if err = cfg.Fprint(buf, fset, root); err != nil {
+		return err
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(file, buf.Bytes(), info.Mode()); err != nil {
+		return err
+	}
+	if delta != 0 {
+		shifts[file] = append(shifts[file], shift{line: line, delta: delta})
+	}
+	return nil
+}
+
+// inlineArg returns the literal argument of expr, if expr is a call to Inline
+// (e.g. test.Inline(`...`)) with a string literal argument.
+func inlineArg(expr ast.Expr) *ast.BasicLit {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil
+	}
+	var name string
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		name = fn.Name
+	case *ast.SelectorExpr:
+		name = fn.Sel.Name
+	}
+	if name != "Inline" {
+		return nil
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+	return lit
+}
+
+// quote returns content as a Go string literal, preferring a raw string
+// literal, which is placed on lines of its own if content spans many lines.
+func quote(content string) string {
+	if strings.ContainsAny(content, "`\r") || !strconv.CanBackquote(strings.ReplaceAll(content, "\n", "")) {
+		return strconv.Quote(content)
+	}
+	if strings.Contains(content, "\n") {
+		return "`\n" + content + "\n`"
+	}
+	return "`" + content + "`"
+}
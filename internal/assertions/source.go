@@ -0,0 +1,265 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package assertions
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// A Source is the source code expression given as an argument of an assertion.
+type Source struct {
+	// Name is the name of the parameter of the assertion, e.g. "exp".
+	Name string
+
+	// Expr is the source code of the argument, e.g. "want.Name".
+	Expr string
+}
+
+type parsed struct {
+	fset *token.FileSet
+	file *ast.File
+}
+
+var (
+	parseLock sync.Mutex
+	sources   = make(map[string]*parsed)
+)
+
+// parse returns the parsed Go source file, caching the result (including any
+// failure to parse) for subsequent assertions made from the same file.
+func parse(name string) *parsed {
+	parseLock.Lock()
+	defer parseLock.Unlock()
+
+	if p, exists := sources[name]; exists {
+		return p
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, nil, 0)
+	if err != nil {
+		sources[name] = nil
+		return nil
+	}
+	p := &parsed{fset: fset, file: file}
+	sources[name] = p
+	return p
+}
+
+// expressions returns the source of each argument given to the assertion
+// implemented by the library function of assertion frame, called from frame.
+// Arguments that are literals, function literals, or calls producing options,
+// along with the T and Settings of the assertion, are omitted. The arguments of
+// a variadic parameter are shown together, or not at all.
+func expressions(frame, assertion runtime.Frame) []Source {
+	params := parameters(assertion)
+	if len(params) == 0 {
+		return nil
+	}
+	call := callAt(frame, funcName(assertion.Function))
+	if call == nil {
+		return nil
+	}
+	p := parse(frame.File)
+
+	var result []Source
+	for i, param := range params {
+		if i >= len(call.Args) {
+			break
+		}
+		switch param.name {
+		case "t", "settings":
+			continue
+		}
+		args := call.Args[i : i+1]
+		if param.variadic {
+			args = call.Args[i:]
+		}
+		if expr, ok := source(p, param, args, param.variadic && call.Ellipsis.IsValid()); ok {
+			result = append(result, Source{Name: param.name, Expr: expr})
+		}
+	}
+	return result
+}
+
+// source returns the source of args given to param, if any is worth showing.
+func source(p *parsed, param parameter, args []ast.Expr, spread bool) (string, bool) {
+	exprs := make([]string, 0, len(args))
+	trivial := true
+	for _, arg := range args {
+		if _, ok := arg.(*ast.FuncLit); ok {
+			return "", false
+		}
+		if _, ok := arg.(*ast.CallExpr); ok && param.option {
+			return "", false
+		}
+		if !literal(arg) {
+			trivial = false
+		}
+		buf := new(bytes.Buffer)
+		if err := printer.Fprint(buf, p.fset, arg); err != nil {
+			return "", false
+		}
+		exprs = append(exprs, buf.String())
+	}
+	expr := strings.Join(exprs, ", ")
+	// a variable of the same name as the parameter adds nothing either
+	if trivial || expr == param.name {
+		return "", false
+	}
+	if spread {
+		expr += "..."
+	}
+	if line, _, multi := strings.Cut(expr, "\n"); multi {
+		expr = line + " …"
+	}
+	return expr, true
+}
+
+// literal reports whether expr is a basic literal or predeclared constant,
+// the source of which adds nothing to the value shown by an assertion.
+func literal(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		return e.Name == "true" || e.Name == "false" || e.Name == "nil"
+	default:
+		return false
+	}
+}
+
+// callAt returns the call expression to the function of the given name made
+// on the line of frame.
+func callAt(frame runtime.Frame, name string) *ast.CallExpr {
+	p := parse(frame.File)
+	if p == nil {
+		return nil
+	}
+	var found *ast.CallExpr
+	ast.Inspect(p.file, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if p.fset.Position(call.Pos()).Line != frame.Line && p.fset.Position(call.Lparen).Line != frame.Line {
+			return true
+		}
+		var fn ast.Expr = call.Fun
+		if index, ok := fn.(*ast.IndexExpr); ok {
+			fn = index.X // explicit type argument, e.g. Eq[int]
+		}
+		switch fn := fn.(type) {
+		case *ast.Ident:
+			if fn.Name == name {
+				found = call
+			}
+		case *ast.SelectorExpr:
+			if fn.Sel.Name == name {
+				found = call
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// A parameter of the library function of an assertion.
+type parameter struct {
+	name     string
+	variadic bool
+	option   bool // of an option or constraint type, e.g. wait.Option
+}
+
+// parameters returns the parameters of the library function of frame, by
+// finding its declaration in the library source.
+func parameters(frame runtime.Frame) []parameter {
+	p := parse(frame.File)
+	if p == nil {
+		return nil
+	}
+	name, recv := funcName(frame.Function), recvName(frame.Function)
+	for _, decl := range p.file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != name || declRecv(fn) != recv {
+			continue
+		}
+		var params []parameter
+		for _, field := range fn.Type.Params.List {
+			_, variadic := field.Type.(*ast.Ellipsis)
+			for _, ident := range field.Names {
+				params = append(params, parameter{
+					name:     ident.Name,
+					variadic: variadic,
+					option:   option(field.Type),
+				})
+			}
+		}
+		return params
+	}
+	return nil
+}
+
+// option reports whether expr is an option or constraint type, the source of
+// the calls making which adds nothing to a failure.
+func option(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ellipsis:
+		return option(e.Elt)
+	case *ast.StarExpr:
+		return option(e.X)
+	case *ast.SelectorExpr:
+		return option(e.Sel)
+	case *ast.Ident:
+		switch e.Name {
+		case "Option", "Constraint", "Setting":
+			return true
+		}
+	}
+	return false
+}
+
+// recvName returns the name of the receiver type of the fully qualified method
+// name, e.g. "github.com/shoenig/test/expect.(*Value[...]).Eq" is "Value", or
+// the empty string for functions.
+func recvName(function string) string {
+	name := strings.TrimPrefix(function, pkgOf(function)+".")
+	name = strings.ReplaceAll(name, "[...]", "")
+	dot := strings.LastIndex(name, ".")
+	if dot < 0 {
+		return ""
+	}
+	return strings.Trim(name[:dot], "(*)")
+}
+
+// declRecv returns the name of the receiver type of fn, or the empty string
+// for functions.
+func declRecv(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		expr = t.X
+	case *ast.IndexListExpr:
+		expr = t.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
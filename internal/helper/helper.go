@@ -0,0 +1,11 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+// Package helper provides an assertion helper for testing how failures made
+// through helper packages are located.
+package helper
+
+// Even asserts n is even by way of the given assertion, e.g. test.True.
+func Even[T, S any](t T, n int, assert func(T, bool, ...S)) {
+	assert(t, n%2 == 0)
+}
@@ -5,26 +5,35 @@
 package test
 
 import (
-	"strings"
-
 	"github.com/shoenig/test/internal/assertions"
 )
 
-func passing(result string) bool {
-	return result == ""
+func passing(result *assertions.Failure) bool {
+	return result == nil
 }
 
-func fail(t T, msg string, scripts ...PostScript) {
+func fail(t T, f *assertions.Failure, scripts ...PostScript) {
 	t.Helper()
-	c := assertions.Caller()
-	s := c + msg + "\n" + run(scripts...)
-	errorf(t, "\n"+strings.TrimSpace(s)+"\n")
+	f.Locate()
+	for _, post := range scripts {
+		f.PostScripts = append(f.PostScripts, post)
+	}
+	if g, ok := t.(*group); ok {
+		g.failures = append(g.failures, f)
+		return
+	}
+	errorf(t, "\n"+assertions.Report(f)+"\n")
 }
 
-func invoke(t T, result string, settings ...Setting) {
+func invoke(t T, result *assertions.Failure, settings ...Setting) {
 	t.Helper()
-	result = strings.TrimSpace(result)
 	if !passing(result) {
+		if lines(settings...) {
+			result.Lines()
+		}
+		if color := colors(settings...); color != nil {
+			result.SetColor(*color)
+		}
 		fail(t, result, scripts(settings...)...)
 	}
 }
@@ -0,0 +1,180 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shoenig/test/interfaces"
+)
+
+// A Matcher decides whether a value of type A is a match, and describes both
+// what it matches and why a value does not match. Use Match to assert a value
+// satisfies a Matcher.
+//
+// Custom assertions implemented as a Matcher produce failure output formatted
+// the same as built-in assertions, and compose with AllOf, AnyOf, Not, Each,
+// and HasField.
+type Matcher[A any] = interfaces.Matcher[A]
+
+type matcher[A any] struct {
+	match    func(A) bool
+	describe func() string
+	mismatch func(A) string
+}
+
+func (m *matcher[A]) Match(value A) bool {
+	return m.match(value)
+}
+
+func (m *matcher[A]) Describe() string {
+	return m.describe()
+}
+
+func (m *matcher[A]) DescribeMismatch(value A) string {
+	return m.mismatch(value)
+}
+
+func was[A any](value A) string {
+	return fmt.Sprintf("was %#v", value)
+}
+
+func describeAll[A any](matchers []Matcher[A]) string {
+	descriptions := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		descriptions = append(descriptions, m.Describe())
+	}
+	return "(" + strings.Join(descriptions, ", ") + ")"
+}
+
+// NewMatcher creates a Matcher described by description, satisfied by values
+// for which match returns true.
+func NewMatcher[A any](description string, match func(A) bool) Matcher[A] {
+	return &matcher[A]{
+		match:    match,
+		describe: func() string { return description },
+		mismatch: was[A],
+	}
+}
+
+// EqualTo creates a Matcher satisfied by values equal to exp using cmp.Equal.
+func EqualTo[A any](exp A, settings ...Setting) Matcher[A] {
+	opts := options(settings...)
+	return &matcher[A]{
+		match:    func(value A) bool { return cmp.Equal(exp, value, opts...) },
+		describe: func() string { return fmt.Sprintf("equal to %#v", exp) },
+		mismatch: was[A],
+	}
+}
+
+// AllOf creates a Matcher satisfied by values satisfying every one of matchers.
+func AllOf[A any](matchers ...Matcher[A]) Matcher[A] {
+	return &matcher[A]{
+		match: func(value A) bool {
+			for _, m := range matchers {
+				if !m.Match(value) {
+					return false
+				}
+			}
+			return true
+		},
+		describe: func() string {
+			return "all of " + describeAll(matchers)
+		},
+		mismatch: func(value A) string {
+			var mismatches []string
+			for _, m := range matchers {
+				if !m.Match(value) {
+					mismatches = append(mismatches, m.DescribeMismatch(value))
+				}
+			}
+			return strings.Join(mismatches, "; ")
+		},
+	}
+}
+
+// AnyOf creates a Matcher satisfied by values satisfying at least one of matchers.
+func AnyOf[A any](matchers ...Matcher[A]) Matcher[A] {
+	return &matcher[A]{
+		match: func(value A) bool {
+			for _, m := range matchers {
+				if m.Match(value) {
+					return true
+				}
+			}
+			return false
+		},
+		describe: func() string {
+			return "any of " + describeAll(matchers)
+		},
+		mismatch: func(value A) string {
+			mismatches := make([]string, 0, len(matchers))
+			for _, m := range matchers {
+				mismatches = append(mismatches, m.DescribeMismatch(value))
+			}
+			return strings.Join(mismatches, "; ")
+		},
+	}
+}
+
+// Not creates a Matcher satisfied by values not satisfying m.
+func Not[A any](m Matcher[A]) Matcher[A] {
+	return &matcher[A]{
+		match: func(value A) bool {
+			return !m.Match(value)
+		},
+		describe: func() string {
+			return "not " + m.Describe()
+		},
+		mismatch: was[A],
+	}
+}
+
+// Each creates a Matcher satisfied by slices where every element satisfies m.
+func Each[A any](m Matcher[A]) Matcher[[]A] {
+	return &matcher[[]A]{
+		match: func(slice []A) bool {
+			for _, item := range slice {
+				if !m.Match(item) {
+					return false
+				}
+			}
+			return true
+		},
+		describe: func() string {
+			return "each element " + m.Describe()
+		},
+		mismatch: func(slice []A) string {
+			var mismatches []string
+			for i, item := range slice {
+				if !m.Match(item) {
+					mismatches = append(mismatches, fmt.Sprintf("element [%d] %s", i, m.DescribeMismatch(item)))
+				}
+			}
+			return strings.Join(mismatches, "; ")
+		},
+	}
+}
+
+// HasField creates a Matcher satisfied by values where the field of the given
+// name, as returned by get, satisfies m.
+//
+// Example,
+//
+//	HasField("Name", func(p Person) string { return p.Name }, EqualTo("alice"))
+func HasField[A, F any](name string, get func(A) F, m Matcher[F]) Matcher[A] {
+	return &matcher[A]{
+		match: func(value A) bool {
+			return m.Match(get(value))
+		},
+		describe: func() string {
+			return "field " + name + " " + m.Describe()
+		},
+		mismatch: func(value A) string {
+			return "field " + name + " " + m.DescribeMismatch(get(value))
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+var (
+	isPositive = NewMatcher("is positive", func(n int) bool { return n > 0 })
+	isEven     = NewMatcher("is even", func(n int) bool { return n%2 == 0 })
+)
+
+// evenMatcher is a custom implementation of Matcher.
+type evenMatcher struct{}
+
+func (evenMatcher) Match(n int) bool {
+	return n%2 == 0
+}
+
+func (evenMatcher) Describe() string {
+	return "an even number"
+}
+
+func (evenMatcher) DescribeMismatch(n int) string {
+	return "remainder of 1"
+}
+
+func TestMatch(t *testing.T) {
+	tc := newCase(t, `expected value to satisfy matcher`)
+	t.Cleanup(tc.assert)
+
+	Match(tc, 3, evenMatcher{})
+}
+
+func TestMatch_pass(t *testing.T) {
+	tc := newCase(t, ``)
+	t.Cleanup(tc.assertNot)
+
+	Match(tc, 4, evenMatcher{})
+	Match(tc, 4, isEven)
+	Match(tc, "abc", EqualTo("abc"))
+}
+
+func TestMatch_PS(t *testing.T) {
+	tc := newCapture(t)
+	t.Cleanup(tc.post)
+
+	Match(tc, 3, isEven, tc.TestPostScript("match"))
+}
+
+func TestMatch_describe(t *testing.T) {
+	tc := newCapture(t)
+
+	Match(tc, 7, AllOf(isPositive, isEven))
+	for _, exp := range []string{
+		"↪ expected: all of (is positive, is even)",
+		"↪   actual: was 7",
+	} {
+		if !strings.Contains(tc.capture, exp) {
+			t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+		}
+	}
+}
+
+func TestMatch_AllOf(t *testing.T) {
+	tc := newCase(t, `all of (is positive, is even)`)
+	t.Cleanup(tc.assert)
+
+	Match(tc, 4, AllOf(isPositive, isEven))
+	Match(tc, -4, AllOf(isPositive, isEven))
+}
+
+func TestMatch_AnyOf(t *testing.T) {
+	tc := newCase(t, `any of (is positive, is even)`)
+	t.Cleanup(tc.assert)
+
+	Match(tc, 3, AnyOf(isPositive, isEven))
+	Match(tc, -4, AnyOf(isPositive, isEven))
+	Match(tc, -3, AnyOf(isPositive, isEven))
+}
+
+func TestMatch_Not(t *testing.T) {
+	tc := newCase(t, `not is even`)
+	t.Cleanup(tc.assert)
+
+	Match(tc, 3, Not(isEven))
+	Match(tc, 4, Not(isEven))
+}
+
+func TestMatch_Each(t *testing.T) {
+	tc := newCase(t, `element [2] was -3`)
+	t.Cleanup(tc.assert)
+
+	Match(tc, []int{1, 2, 3}, Each(isPositive))
+	Match(tc, []int{1, 2, -3}, Each(isPositive))
+}
+
+func TestMatch_HasField(t *testing.T) {
+	tc := newCase(t, `field Name equal to "Bob"`)
+	t.Cleanup(tc.assert)
+
+	name := func(p *Person) string { return p.Name }
+	Match(tc, &Person{Name: "Alice"}, HasField("Name", name, EqualTo("Alice")))
+	Match(tc, &Person{Name: "Alice"}, HasField("Name", name, EqualTo("Bob")))
+}
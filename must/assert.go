@@ -14,3 +14,7 @@ func errorf(t T, msg string, args ...any) {
 	t.Helper()
 	t.Fatalf(msg, args...)
 }
+
+func (g *group) Fatalf(msg string, args ...any) {
+	g.record(msg, args...)
+}
@@ -8,8 +8,15 @@ package must
 import (
 	"strings"
 	"testing"
+
+	"github.com/shoenig/test/internal/assertions"
 )
 
+func init() {
+	// test cases inspect output without regard for the terminal
+	assertions.SetColor(false)
+}
+
 type testScript struct {
 	label   string
 	content string
@@ -44,6 +51,10 @@ func (it *internalTest) Helper() {
 	it.helper = true
 }
 
+func (it *internalTest) Cleanup(f func()) {
+	it.t.Cleanup(f)
+}
+
 func (it *internalTest) assert() {
 	if !it.helper {
 		it.t.Fatal("should be marked as helper")
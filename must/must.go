@@ -8,8 +8,10 @@ package must
 import (
 	"io"
 	"io/fs"
+	"iter"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/shoenig/test/interfaces"
 	"github.com/shoenig/test/internal/assertions"
@@ -118,6 +120,19 @@ func EqFunc[A any](t T, exp, val A, eq func(a, b A) bool, settings ...Setting) {
 	invoke(t, assertions.EqFunc(exp, val, eq), settings...)
 }
 
+// EqPartial asserts the non-zero fields of exp are equal to those of val using
+// cmp.Equal, ignoring zero fields of exp. Pointers, interfaces, structs, slices,
+// arrays, and maps are compared recursively, with mismatches reported by path,
+// e.g. ".Spec.Containers[2].Image". Slices and arrays must be of the same length,
+// but keys of val missing from a map of exp are ignored.
+//
+// Use Fields to instead compare only the values at specific paths, including
+// zero values.
+func EqPartial[A any](t T, exp, val A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.EqPartial(exp, val, fields(settings...), options(settings...), maxDiffs(settings...)), settings...)
+}
+
 // NotEq asserts exp and val are not equal using cmp.Equal.
 func NotEq[A any](t T, exp, val A, settings ...Setting) {
 	t.Helper()
@@ -142,6 +157,23 @@ func EqJSON(t T, exp, val string, settings ...Setting) {
 	invoke(t, assertions.EqJSON(exp, val), settings...)
 }
 
+// JSONPath asserts the value found in the JSON document doc at path is equal to
+// exp, as if exp were marshaled to JSON. The path is of the form "$.items[0].name",
+// where object members may also be selected like `$["content-type"]`.
+func JSONPath[A any](t T, doc, path string, exp A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.JSONPath(doc, path, exp), settings...)
+}
+
+// JSONContains asserts the JSON document doc contains the JSON document sub,
+// i.e. each member of an object in sub must be present and match in doc, while
+// other members in doc are ignored. Arrays must be of the same length, with
+// elements matched in order unless configured by UnorderedArrays.
+func JSONContains(t T, doc, sub string, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.JSONContains(doc, sub, unorderedArrays(settings...), maxDiffs(settings...)), settings...)
+}
+
 // ValidJSON asserts js is valid JSON.
 func ValidJSON(t T, js string, settings ...Setting) {
 	t.Helper()
@@ -190,6 +222,27 @@ func SliceEqOp[A comparable, S ~[]A](t T, exp, val S, settings ...Setting) {
 	invoke(t, assertions.SliceEqOp(exp, val), settings...)
 }
 
+// SliceEqUnordered asserts exp and val contain the same elements with the same
+// number of occurrences, in any order, using cmp.Equal to compare elements.
+func SliceEqUnordered[A any](t T, exp, val []A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SliceEqUnordered(exp, val, options(settings...)), settings...)
+}
+
+// SliceEqUnorderedFunc asserts exp and val contain the same elements with the
+// same number of occurrences, in any order, using eq to compare elements.
+func SliceEqUnorderedFunc[A any](t T, exp, val []A, eq func(a, b A) bool, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SliceEqUnorderedFunc(exp, val, eq), settings...)
+}
+
+// SliceEqUnorderedOp asserts exp and val contain the same elements with the
+// same number of occurrences, in any order, using == to compare elements.
+func SliceEqUnorderedOp[C comparable](t T, exp, val []C, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SliceEqUnorderedOp(exp, val), settings...)
+}
+
 // SliceEmpty asserts slice is empty.
 func SliceEmpty[A any](t T, slice []A, settings ...Setting) {
 	t.Helper()
@@ -319,6 +372,65 @@ func SliceContainsSubset[A any](t T, slice, items []A, settings ...Setting) {
 	invoke(t, assertions.SliceContainsSubset(slice, items, options(settings...)...), settings...)
 }
 
+// SeqEq asserts the items of seq are equal to exp, using cmp.Equal to compare
+// items.
+//
+// At most DefaultMaxItems are consumed from seq, unless configured by MaxItems.
+func SeqEq[A any](t T, exp []A, seq iter.Seq[A], settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SeqEq(exp, seq, maxItems(settings...), options(settings...)...), settings...)
+}
+
+// Seq2Eq asserts the pairs of seq are equal to the pairs of exp, in the same
+// order, using cmp.Equal to compare keys and values.
+//
+// At most DefaultMaxItems are consumed from each sequence, unless configured
+// by MaxItems.
+func Seq2Eq[K, V any](t T, exp, seq iter.Seq2[K, V], settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Seq2Eq(exp, seq, maxItems(settings...), options(settings...)...), settings...)
+}
+
+// SeqLen asserts seq produces n items.
+//
+// At most DefaultMaxItems are consumed from seq, unless configured by MaxItems.
+func SeqLen[A any](t T, n int, seq iter.Seq[A], settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SeqLen(n, seq, maxItems(settings...)), settings...)
+}
+
+// SeqEmpty asserts seq produces no items.
+func SeqEmpty[A any](t T, seq iter.Seq[A], settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SeqEmpty(seq), settings...)
+}
+
+// SeqContains asserts item is produced by seq, using cmp.Equal to compare items.
+//
+// At most DefaultMaxItems are consumed from seq, unless configured by MaxItems.
+func SeqContains[A any](t T, seq iter.Seq[A], item A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SeqContains(seq, item, maxItems(settings...), options(settings...)...), settings...)
+}
+
+// SeqContainsAll asserts seq and items contain the same elements, but in no
+// particular order, using cmp.Equal to compare elements. The number of items
+// produced by seq and in items must be the same.
+//
+// At most DefaultMaxItems are consumed from seq, unless configured by MaxItems.
+func SeqContainsAll[A any](t T, seq iter.Seq[A], items []A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SeqContainsAll(seq, items, maxItems(settings...), options(settings...)...), settings...)
+}
+
+// SeqAscending asserts each item produced by seq is ≤ the item that follows.
+//
+// At most DefaultMaxItems are consumed from seq, unless configured by MaxItems.
+func SeqAscending[O constraints.Ordered](t T, seq iter.Seq[O], settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.SeqAscending(seq, maxItems(settings...)), settings...)
+}
+
 // Positive asserts n > 0.
 func Positive[N interfaces.Number](t T, n N, settings ...Setting) {
 	t.Helper()
@@ -491,30 +603,33 @@ func InDeltaSlice[N interfaces.Number](t T, a, b []N, delta N, settings ...Setti
 
 // MapEq asserts maps exp and val contain the same key/val pairs, using
 // cmp.Equal function to compare vals.
+//
+// Every missing key, extra key, and differing val is reported, up to
+// DefaultMaxDiffs unless configured by MaxDiffs.
 func MapEq[M1, M2 interfaces.Map[K, V], K comparable, V any](t T, exp M1, val M2, settings ...Setting) {
 	t.Helper()
-	invoke(t, assertions.MapEq(exp, val, options(settings...)), settings...)
+	invoke(t, assertions.MapEq(exp, val, options(settings...), maxDiffs(settings...)), settings...)
 }
 
 // MapEqFunc asserts maps exp and val contain the same key/val pairs, using eq to
 // compare vals.
 func MapEqFunc[M1, M2 interfaces.Map[K, V], K comparable, V any](t T, exp M1, val M2, eq func(V, V) bool, settings ...Setting) {
 	t.Helper()
-	invoke(t, assertions.MapEqFunc(exp, val, eq), settings...)
+	invoke(t, assertions.MapEqFunc(exp, val, eq, maxDiffs(settings...)), settings...)
 }
 
 // MapEqual asserts maps exp and val contain the same key/val pairs, using Equal
 // method to compare val
 func MapEqual[M interfaces.MapEqualFunc[K, V], K comparable, V interfaces.EqualFunc[V]](t T, exp, val M, settings ...Setting) {
 	t.Helper()
-	invoke(t, assertions.MapEqual(exp, val), settings...)
+	invoke(t, assertions.MapEqual(exp, val, maxDiffs(settings...)), settings...)
 }
 
 // MapEqOp asserts maps exp and val contain the same key/val pairs, using == to
 // compare vals.
 func MapEqOp[M interfaces.Map[K, V], K, V comparable](t T, exp M, val M, settings ...Setting) {
 	t.Helper()
-	invoke(t, assertions.MapEqOp(exp, val), settings...)
+	invoke(t, assertions.MapEqOp(exp, val, maxDiffs(settings...)), settings...)
 }
 
 // MapLen asserts map is of size n.
@@ -631,6 +746,28 @@ func MapNotContainsValueEqual[M ~map[K]V, K comparable, V interfaces.EqualFunc[V
 	invoke(t, assertions.MapNotContainsValueEqual(m, val), settings...)
 }
 
+// Path asserts the value found by navigating v along path is equal to exp
+// using cmp.Equal. The path navigates struct fields, map keys, and slice and
+// array indexes, through any pointers and interfaces, e.g.
+// "Spec.Containers[0].Image" or `.Labels["app"]`.
+func Path[A any](t T, v any, path string, exp A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Path(v, path, exp, options(settings...)), settings...)
+}
+
+// PathExists asserts path can be navigated in v, e.g. without encountering a
+// nil pointer, missing map key, or out of range index.
+func PathExists(t T, v any, path string, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.PathExists(v, path), settings...)
+}
+
+// PathNotExists asserts path cannot be navigated in v.
+func PathNotExists(t T, v any, path string, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.PathNotExists(v, path), settings...)
+}
+
 // FileExistsFS asserts file exists on the fs.FS filesystem.
 //
 // Example,
@@ -738,6 +875,49 @@ func FileContains(t T, file, content string, settings ...Setting) {
 	invoke(t, assertions.FileContains(file, content), settings...)
 }
 
+// Golden asserts got matches the content of the golden file of the given name,
+// located at testdata/<TestName>/<name>.golden. A mismatch is reported as a
+// unified line diff.
+//
+// If the test binary defines an -update flag and it is set, or if the
+// TEST_UPDATE_GOLDEN environment variable is set to a true value, the golden
+// file is written with the content of got instead.
+//
+// Use NormalizeLineEndings or TrimTrailingSpace to ignore insignificant
+// differences in content.
+func Golden(t T, name string, got []byte, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Golden(goldenFile(t, name), got, update(), normalizer(settings...)), settings...)
+}
+
+// GoldenJSON asserts the indented JSON encoding of v matches the content of the
+// golden file of the given name, located at testdata/<TestName>/<name>.golden.
+//
+// See Golden for how golden files are updated.
+func GoldenJSON(t T, name string, v any, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.GoldenJSON(goldenFile(t, name), v, update(), normalizer(settings...)), settings...)
+}
+
+// Snapshot asserts got matches the inline snapshot, i.e. the content given to
+// Inline. A mismatch is reported as a unified line diff.
+//
+// Strings, byte slices, and fmt.Stringer values are compared verbatim, other
+// values by their Go syntax representation.
+//
+// If the test binary defines an -update flag and it is set, or if the
+// TEST_UPDATE_GOLDEN environment variable is set to a true value, the string
+// literal given to Inline is instead rewritten in the test source to match got.
+//
+// Example,
+//
+//	Snapshot(t, strings.ToUpper("hello"), Inline(`HELLO`))
+func Snapshot[A any](t T, got A, snapshot InlineSnapshot, settings ...Setting) {
+	t.Helper()
+	normalize := normalizer(settings...)
+	invoke(t, assertions.Snapshot(normalize(snapshot.content), normalize(render(got)), update()), settings...)
+}
+
 // FilePathValid asserts path is a valid file path.
 func FilePathValid(t T, path string, settings ...Setting) {
 	t.Helper()
@@ -907,6 +1087,80 @@ func Wait(t T, wc *wait.Constraint, settings ...Setting) {
 	invoke(t, assertions.Wait(wc), settings...)
 }
 
+// NoGoroutineLeaks asserts no goroutines started during the test case are still
+// running once the test case is complete, by recording the goroutines running
+// now and checking them again via Cleanup. Goroutines are given time to finish
+// as configured by LeakTimeout.
+//
+// Use IgnoreTopFunction or IgnoreStackMatching to ignore goroutines expected
+// to outlive the test case.
+//
+// Example,
+//
+//	func TestScheduler(t *testing.T) {
+//		NoGoroutineLeaks(t, IgnoreTopFunction("net/http.(*persistConn).readLoop"))
+//		// ...
+//	}
+func NoGoroutineLeaks(t T, settings ...Setting) {
+	t.Helper()
+	c, ok := t.(interface{ Cleanup(func()) })
+	if !ok {
+		invoke(t, assertions.NoCleanup(), settings...)
+		return
+	}
+	check := assertions.NoGoroutineLeaks(leaks(settings...))
+	c.Cleanup(func() {
+		t.Helper()
+		invoke(t, check(), settings...)
+	})
+}
+
+// Receives asserts a value equal to exp is received from ch within timeout,
+// using cmp.Equal to compare values.
+func Receives[A any](t T, ch <-chan A, exp A, timeout time.Duration, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Receives(ch, exp, timeout, options(settings...)...), settings...)
+}
+
+// ReceivesWithin asserts a value is received from ch within timeout.
+func ReceivesWithin[A any](t T, ch <-chan A, timeout time.Duration, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.ReceivesWithin(ch, timeout), settings...)
+}
+
+// NoReceive asserts no value is received from ch for the given duration, and
+// that ch is not closed.
+func NoReceive[A any](t T, ch <-chan A, duration time.Duration, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.NoReceive(ch, duration), settings...)
+}
+
+// Closed asserts ch is closed within timeout, without first receiving a value.
+func Closed[A any](t T, ch <-chan A, timeout time.Duration, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Closed(ch, timeout), settings...)
+}
+
+// Drains asserts n values are received from ch within timeout.
+func Drains[A any](t T, ch <-chan A, n int, timeout time.Duration, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Drains(ch, n, timeout), settings...)
+}
+
+// ChanLen asserts ch has n values buffered.
+func ChanLen[A any](t T, n int, ch <-chan A, settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.ChanLen(n, ch), settings...)
+}
+
+// Match asserts value satisfies the Matcher m.
+//
+// Matchers may be composed using AllOf, AnyOf, Not, Each, and HasField.
+func Match[A any](t T, value A, m Matcher[A], settings ...Setting) {
+	t.Helper()
+	invoke(t, assertions.Match(value, m), settings...)
+}
+
 // Tweak is used to modify a struct and assert its Equal method captures the
 // modification.
 //
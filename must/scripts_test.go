@@ -23,29 +23,26 @@ func TestPostScript_Content(t *testing.T) {
 
 func TestPostScript_Sprintf(t *testing.T) {
 	ps := Sprintf("foo %s %d", "baz", 1)
-	result := run(scripts(ps)...)
-	exp := "↪ PostScript | annotation ↷\n\tfoo baz 1\n"
-	if result != exp {
-		t.Fatalf("exp %s, got %s", exp, result)
-	}
+	tc := newCase(t, "↪ PostScript | annotation ↷\n\tfoo baz 1")
+	t.Cleanup(tc.assert)
+
+	True(tc, false, ps)
 }
 
 func TestPostScript_KV(t *testing.T) {
 	ps := Values("one", 1, "foo", "bar")
-	result := run(scripts(ps)...)
-	exp := "↪ PostScript | mapping ↷\n\t\"one\" => 1\n\t\"foo\" => \"bar\"\n"
-	if result != exp {
-		t.Fatalf("exp %s, got %s", exp, result)
-	}
+	tc := newCase(t, "↪ PostScript | mapping ↷\n\t\"one\" => 1\n\t\"foo\" => \"bar\"")
+	t.Cleanup(tc.assert)
+
+	True(tc, false, ps)
 }
 
 func TestPostScript_Func(t *testing.T) {
 	ps := Func(func() string {
 		return "hello"
 	})
-	result := run(scripts(ps)...)
-	exp := "↪ PostScript | function ↷\n\thello\n"
-	if result != exp {
-		t.Fatalf("exp %s, got %s", exp, result)
-	}
+	tc := newCase(t, "↪ PostScript | function ↷\n\thello")
+	t.Cleanup(tc.assert)
+
+	True(tc, false, ps)
 }
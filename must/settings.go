@@ -6,7 +6,13 @@
 package must
 
 import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
 	"github.com/google/go-cmp/cmp"
+	"github.com/shoenig/test/internal/assertions"
 )
 
 // Settings are used to manage a collection of Setting values used to modify
@@ -16,9 +22,21 @@ import (
 // Use Cmp for specifying custom cmp.Option values.
 //
 // Use Sprint, Sprintf, Values, Func for specifying custom failure output messages.
+//
+// Use NormalizeLineEndings, TrimTrailingSpace for normalizing content compared
+// against golden files.
 type Settings struct {
 	postScripts []PostScript
 	cmpOptions  []cmp.Option
+	normalizers []func(string) string
+	lines       bool
+	color       *bool
+	maxItems    int
+	maxDiffs    int
+	fields      []string
+	unordered   bool
+	leaks       assertions.LeakFilter
+	leakTimeout time.Duration
 }
 
 // A Setting changes the behavior of a test case assertion.
@@ -43,6 +61,203 @@ func options(settings ...Setting) []cmp.Option {
 	return s.cmpOptions
 }
 
+// NormalizeLineEndings converts Windows (CRLF) line endings to Unix (LF) line
+// endings before comparing content, e.g. with Golden.
+func NormalizeLineEndings() Setting {
+	return func(s *Settings) {
+		s.normalizers = append(s.normalizers, func(content string) string {
+			return strings.ReplaceAll(content, "\r\n", "\n")
+		})
+	}
+}
+
+// TrimTrailingSpace removes trailing whitespace from each line before comparing
+// content, e.g. with Golden.
+func TrimTrailingSpace() Setting {
+	return func(s *Settings) {
+		s.normalizers = append(s.normalizers, func(content string) string {
+			lines := strings.Split(content, "\n")
+			for i, line := range lines {
+				lines[i] = strings.TrimRight(line, " \t\r")
+			}
+			return strings.Join(lines, "\n")
+		})
+	}
+}
+
+// Lines forces the difference of values compared by an assertion to be shown
+// as a line oriented unified diff. By default a unified diff is used only for
+// strings and byte slices spanning multiple lines.
+func Lines() Setting {
+	return func(s *Settings) {
+		s.lines = true
+	}
+}
+
+func lines(settings ...Setting) bool {
+	s := new(Settings)
+	for _, setting := range settings {
+		setting(s)
+	}
+	return s.lines
+}
+
+// Color explicitly enables or disables colorized failure output, overriding
+// the default of using color only when output is to a terminal and the NO_COLOR
+// environment variable is not set.
+//
+// The TEST_COLOR environment variable may also be used to enable or disable
+// colorized output of all assertions.
+func Color(enabled bool) Setting {
+	return func(s *Settings) {
+		s.color = &enabled
+	}
+}
+
+func colors(settings ...Setting) *bool {
+	s := new(Settings)
+	for _, setting := range settings {
+		setting(s)
+	}
+	return s.color
+}
+
+// DefaultMaxItems is the maximum number of items consumed from a sequence by
+// the Seq family of assertions, unless configured otherwise by MaxItems.
+const DefaultMaxItems = 10_000
+
+// MaxItems sets the maximum number of items consumed from a sequence by the Seq
+// family of assertions, guarding against sequences that never end. A sequence
+// producing more than n items causes the assertion to fail.
+//
+// A value of n less than or equal to zero consumes every item, as with MaxDiffs
+// rendering every difference. Such an assertion of an infinite sequence never
+// returns.
+func MaxItems(n int) Setting {
+	return func(s *Settings) {
+		s.maxItems = n
+	}
+}
+
+func maxItems(settings ...Setting) int {
+	s := &Settings{maxItems: DefaultMaxItems}
+	for _, setting := range settings {
+		setting(s)
+	}
+	if s.maxItems <= 0 {
+		return math.MaxInt
+	}
+	return s.maxItems
+}
+
+// DefaultMaxDiffs is the maximum number of differences rendered by the MapEq
+// family of assertions, EqPartial, and JSONContains, unless configured otherwise
+// by MaxDiffs.
+const DefaultMaxDiffs = 25
+
+// MaxDiffs sets the maximum number of differences rendered by the MapEq family
+// of assertions, EqPartial, and JSONContains, e.g. missing keys, extra keys, and
+// differing values. The count of any remaining differences is still reported.
+//
+// A value of n less than or equal to zero renders every difference.
+func MaxDiffs(n int) Setting {
+	return func(s *Settings) {
+		s.maxDiffs = n
+	}
+}
+
+func maxDiffs(settings ...Setting) int {
+	s := &Settings{maxDiffs: DefaultMaxDiffs}
+	for _, setting := range settings {
+		setting(s)
+	}
+	if s.maxDiffs <= 0 {
+		return math.MaxInt
+	}
+	return s.maxDiffs
+}
+
+// Fields causes EqPartial to compare only the values at each of paths, e.g.
+// ".Spec.Containers[2].Image" or `.Labels["app"]`, rather than the non-zero
+// fields of the expected value.
+func Fields(paths ...string) Setting {
+	return func(s *Settings) {
+		s.fields = append(s.fields, paths...)
+	}
+}
+
+func fields(settings ...Setting) []string {
+	s := new(Settings)
+	for _, setting := range settings {
+		setting(s)
+	}
+	return s.fields
+}
+
+// UnorderedArrays causes JSONContains to match the elements of arrays in any
+// order, rather than by index.
+func UnorderedArrays() Setting {
+	return func(s *Settings) {
+		s.unordered = true
+	}
+}
+
+func unorderedArrays(settings ...Setting) bool {
+	s := new(Settings)
+	for _, setting := range settings {
+		setting(s)
+	}
+	return s.unordered
+}
+
+// IgnoreTopFunction causes NoGoroutineLeaks to ignore goroutines where the
+// function at the top of the stack is the fully qualified name, e.g.
+// "internal/poll.runtime_pollWait".
+func IgnoreTopFunction(name string) Setting {
+	return func(s *Settings) {
+		s.leaks.TopFunctions = append(s.leaks.TopFunctions, name)
+	}
+}
+
+// IgnoreStackMatching causes NoGoroutineLeaks to ignore goroutines where the
+// stack trace matches re.
+func IgnoreStackMatching(re *regexp.Regexp) Setting {
+	return func(s *Settings) {
+		s.leaks.Stacks = append(s.leaks.Stacks, re)
+	}
+}
+
+// LeakTimeout sets the amount of time NoGoroutineLeaks waits for goroutines
+// to finish before they are considered to be leaked.
+//
+// Default 1 second.
+func LeakTimeout(duration time.Duration) Setting {
+	return func(s *Settings) {
+		s.leakTimeout = duration
+	}
+}
+
+func leaks(settings ...Setting) (assertions.LeakFilter, time.Duration) {
+	s := &Settings{leakTimeout: 1 * time.Second}
+	for _, setting := range settings {
+		setting(s)
+	}
+	return s.leaks, s.leakTimeout
+}
+
+func normalizer(settings ...Setting) func(string) string {
+	s := new(Settings)
+	for _, setting := range settings {
+		setting(s)
+	}
+	return func(content string) string {
+		for _, normalize := range s.normalizers {
+			content = normalize(content)
+		}
+		return content
+	}
+}
+
 func scripts(settings ...Setting) []PostScript {
 	s := new(Settings)
 	for _, setting := range settings {
@@ -6,9 +6,12 @@
 package must
 
 import (
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/shoenig/test/internal/assertions"
 )
 
 var cmpSortSlices = Cmp(cmpopts.SortSlices(func(i, j int) bool {
@@ -50,3 +53,47 @@ func TestCmp_MapNotContainsValues(t *testing.T) {
 	}
 	MapNotContainsValues(t, m1, [][]int{{0, 5, 1, 3}}, cmpSortSlices)
 }
+
+func TestColor_enabled(t *testing.T) {
+	tc := newCase(t, "\x1b[31m-one\x1b[0m\n\x1b[32m+ONE\x1b[0m")
+	t.Cleanup(tc.assert)
+
+	Eq(tc, "one\ntwo", "ONE\ntwo", Color(true))
+}
+
+func TestColor_labels(t *testing.T) {
+	tc := newCase(t, "\x1b[2m↪ PostScript | annotation ↷\x1b[0m")
+	t.Cleanup(tc.assert)
+
+	Eq(tc, 1, 2, Color(true), Sprint("extra"))
+}
+
+func TestColor_concurrent(t *testing.T) {
+	// the default is set while failures are rendered, e.g. by parallel tests
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assertions.SetColor(false)
+		}()
+		go func() {
+			defer wg.Done()
+			tc := newCapture(t)
+			Eq(tc, 1, 2)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestColor_disabled(t *testing.T) {
+	tc := newCase(t, "-one\n+ONE")
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		if strings.Contains(tc.capture, "\x1b[") {
+			t.Fatalf("expected no color in output, got %q", tc.capture)
+		}
+	})
+
+	Eq(tc, "one\ntwo", "ONE\ntwo", Color(false))
+}
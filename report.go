@@ -0,0 +1,88 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"github.com/shoenig/test/internal/assertions"
+)
+
+// A Failure describes why an assertion failed. Failures are produced as data by
+// each failed assertion, and are rendered into test case output by a Reporter.
+type Failure = assertions.Failure
+
+// A Source is the source code expression given as an argument of a failed
+// assertion, e.g. the "want.Name" of Eq(t, want.Name, got.Name).
+type Source = assertions.Source
+
+// A Reporter is used to report failed assertions. Report is given the failures
+// to report, of which there may be many in the case of a Group, and returns the
+// content to be logged through the T of the test case.
+//
+// A custom Reporter may be used to receive failures as data, e.g. for gathering
+// statistics or producing a custom output format.
+type Reporter interface {
+	Report(failures []*Failure) string
+}
+
+// TextReporter creates a Reporter producing human readable text, the default.
+func TextReporter() Reporter {
+	return assertions.TextReporter{}
+}
+
+// SetReporter sets the Reporter used to report failed assertions of both the
+// test and must packages, returning the previous Reporter. Setting a nil
+// Reporter restores the default TextReporter.
+func SetReporter(r Reporter) Reporter {
+	return assertions.SetReporter(r)
+}
+
+// JSONReporter creates a Reporter producing a single line JSON object for each
+// failed assertion. The object contains the file, line, assertion name, message,
+// expected and actual values, difference, and PostScripts of the failure.
+func JSONReporter() Reporter {
+	return assertions.JSONReporter{}
+}
+
+// Output is a format in which failed assertions are reported.
+type Output int
+
+const (
+	// OutputText reports failures as human readable text, the default.
+	OutputText Output = iota
+
+	// OutputJSON reports each failure as a single line JSON object, which may
+	// be parsed from the Output field of events produced by go test -json.
+	//
+	// OutputJSON may also be enabled by setting the TEST_OUTPUT environment
+	// variable to "json".
+	OutputJSON
+)
+
+// SetOutput sets the format in which failed assertions of both the test and
+// must packages are reported, returning the previous Reporter.
+func SetOutput(o Output) Reporter {
+	switch o {
+	case OutputJSON:
+		return SetReporter(JSONReporter())
+	default:
+		return SetReporter(TextReporter())
+	}
+}
+
+// RegisterHelpers registers packages implementing helper functions which make
+// assertions of the test and must packages, given by import path.
+//
+// The location reported by a failed assertion made through a helper function
+// is that of the test code calling the helper, rather than that of the helper
+// itself. Only helper functions implemented outside of _test.go files are
+// recognized.
+//
+// Example,
+//
+//	func init() {
+//		test.RegisterHelpers("github.com/example/project/testutil")
+//	}
+func RegisterHelpers(packages ...string) {
+	assertions.RegisterHelpers(packages...)
+}
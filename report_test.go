@@ -0,0 +1,334 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package test
+
+import (
+	"encoding/json"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test/internal/assertions"
+	"github.com/shoenig/test/internal/helper"
+	"github.com/shoenig/test/wait"
+)
+
+type recorder struct {
+	failures []*Failure
+}
+
+func (r *recorder) Report(failures []*Failure) string {
+	r.failures = append(r.failures, failures...)
+	return "recorded"
+}
+
+func useReporter(t *testing.T, r Reporter) {
+	previous := SetReporter(r)
+	t.Cleanup(func() {
+		SetReporter(previous)
+	})
+}
+
+func TestReporter(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	Eq(tc, "one", "two", Sprint("extra"))
+
+	if len(r.failures) != 1 {
+		t.Fatalf("expected 1 failure, got %d", len(r.failures))
+	}
+	f := r.failures[0]
+	switch {
+	case f.Kind != "Eq":
+		t.Fatalf("expected kind Eq, got %q", f.Kind)
+	case f.File != "report_test.go":
+		t.Fatalf("expected file report_test.go, got %q", f.File)
+	case f.Line == 0:
+		t.Fatal("expected line to be set")
+	case f.Message != "expected equality via cmp.Equal function":
+		t.Fatalf("unexpected message %q", f.Message)
+	case f.Expected != `"one"` || f.Actual != `"two"`:
+		t.Fatalf("unexpected values %s and %s", f.Expected, f.Actual)
+	case !strings.Contains(f.Diff, `"one"`) || !strings.Contains(f.Diff, `"two"`):
+		t.Fatalf("unexpected diff %q", f.Diff)
+	case len(f.PostScripts) != 1 || f.PostScripts[0].Label() != "annotation":
+		t.Fatalf("unexpected post scripts %v", f.PostScripts)
+	}
+}
+
+func TestReporter_bullets(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	StrHasPrefix(tc, "foo", "bar")
+
+	f := r.failures[0]
+	if f.Kind != "StrHasPrefix" {
+		t.Fatalf("expected kind StrHasPrefix, got %q", f.Kind)
+	}
+	if len(f.Bullets) != 2 || f.Bullets[0] != "prefix: foo" || f.Bullets[1] != "string: bar" {
+		t.Fatalf("unexpected bullets %q", f.Bullets)
+	}
+}
+
+func TestReporter_elements(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	SliceEqFunc(tc, []int{1, 2, 3}, []int{1, 5, 3}, func(a, b int) bool {
+		return a == b
+	})
+
+	f := r.failures[0]
+	if f.Expected != "" || f.Actual != "" {
+		t.Fatalf("expected values as a whole left out, got %s and %s", f.Expected, f.Actual)
+	}
+	if !strings.Contains(f.Diff, "- [1] 2\n+ [1] 5\n") {
+		t.Fatalf("unexpected diff %q", f.Diff)
+	}
+}
+
+func TestReporter_group(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	Group(tc, func(g T) {
+		True(g, false)
+		Nil(g, 1)
+	})
+
+	if len(r.failures) != 2 {
+		t.Fatalf("expected 2 failures, got %d", len(r.failures))
+	}
+	if r.failures[0].Kind != "True" || r.failures[1].Kind != "Nil" {
+		t.Fatalf("unexpected kinds %q and %q", r.failures[0].Kind, r.failures[1].Kind)
+	}
+}
+
+func TestReporter_sources(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	want, got := Person{Name: "alice"}, Person{Name: "bob"}
+	Eq(tc, want.Name, got.Name)
+	Eq(tc, "alice", got.Name)
+	{
+		exp, val := want, got
+		Eq(tc, exp, val)
+	}
+
+	if len(r.failures) != 3 {
+		t.Fatalf("expected 3 failures, got %d", len(r.failures))
+	}
+	exp := []Source{{Name: "exp", Expr: "want.Name"}, {Name: "val", Expr: "got.Name"}}
+	if !reflect.DeepEqual(r.failures[0].Sources, exp) {
+		t.Fatalf("unexpected sources %v", r.failures[0].Sources)
+	}
+	exp = []Source{{Name: "val", Expr: "got.Name"}}
+	if !reflect.DeepEqual(r.failures[1].Sources, exp) {
+		t.Fatalf("unexpected sources %v", r.failures[1].Sources)
+	}
+	if sources := r.failures[2].Sources; len(sources) != 0 {
+		t.Fatalf("expected no sources named as their parameter, got %v", sources)
+	}
+}
+
+func TestReporter_sourcesOmitted(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	names := []string{"alice"}
+	Panic(tc, func() {
+		// comment
+		_ = names
+	})
+	SliceEqFunc(tc, names, []string{"bob"}, func(a, b string) bool { return a == b })
+	Wait(tc, wait.InitialSuccess(wait.BoolFunc(func() bool { return false }), wait.Attempts(1)))
+	Eq(tc, []string{
+		"carol",
+	}, names)
+
+	exp := [][]Source{
+		nil,
+		{{Name: "exp", Expr: "names"}, {Name: "val", Expr: `[]string{"bob"}`}},
+		nil,
+		{{Name: "exp", Expr: "[]string{ …"}, {Name: "val", Expr: "names"}},
+	}
+	if len(r.failures) != len(exp) {
+		t.Fatalf("expected %d failures, got %d", len(exp), len(r.failures))
+	}
+	for i, f := range r.failures {
+		if !reflect.DeepEqual(f.Sources, exp[i]) {
+			t.Fatalf("unexpected sources of failure %d: %v", i, f.Sources)
+		}
+	}
+}
+
+func TestSources_text(t *testing.T) {
+	tc := newCase(t, "expected condition to be true; is false\n↪ condition: len(items) > 3")
+	t.Cleanup(tc.assert)
+
+	items := []int{1, 2}
+	True(tc, len(items) > 3)
+}
+
+func TestRegisterHelpers(t *testing.T) {
+	r := new(recorder)
+	useReporter(t, r)
+
+	tc := newCase(t, `recorded`)
+	t.Cleanup(tc.assert)
+
+	helper.Even[T, Setting](tc, 3, True) // location is within helper package
+	RegisterHelpers("github.com/shoenig/test/internal/helper")
+	t.Cleanup(func() {
+		assertions.UnregisterHelpers("github.com/shoenig/test/internal/helper")
+	})
+	_, _, line, _ := runtime.Caller(0)
+	helper.Even[T, Setting](tc, 3, True) // location is here
+
+	if len(r.failures) != 2 {
+		t.Fatalf("expected 2 failures, got %d", len(r.failures))
+	}
+	if f := r.failures[0]; f.File != "helper.go" {
+		t.Fatalf("expected location in helper.go, got %s:%d", f.File, f.Line)
+	}
+	if f := r.failures[1]; f.File != "report_test.go" || f.Line != line+1 {
+		t.Fatalf("expected location report_test.go:%d, got %s:%d", line+1, f.File, f.Line)
+	}
+	if f := r.failures[1]; f.Kind != "True" {
+		t.Fatalf("expected kind True, got %q", f.Kind)
+	}
+}
+
+func TestStack(t *testing.T) {
+	tc := newCase(t, "↪ PostScript | stack ↷\n\tgithub.com/shoenig/test")
+	t.Cleanup(tc.assert)
+	t.Cleanup(func() {
+		if strings.Contains(tc.capture, "invoke") {
+			t.Fatalf("expected library frames to be omitted, got %q", tc.capture)
+		}
+	})
+
+	True(tc, false, Stack())
+}
+
+func TestReporter_text(t *testing.T) {
+	useReporter(t, TextReporter())
+
+	tc := newCase(t, `report_test.go:`)
+	t.Cleanup(tc.assert)
+
+	EqOp(tc, 1, 2)
+}
+
+func TestSetReporter_nil(t *testing.T) {
+	useReporter(t, new(recorder))
+	SetReporter(nil)
+
+	tc := newCase(t, `expected equality via ==`)
+	t.Cleanup(tc.assert)
+
+	EqOp(tc, 1, 2)
+}
+
+func TestSetOutput_json(t *testing.T) {
+	previous := SetOutput(OutputJSON)
+	t.Cleanup(func() {
+		SetReporter(previous)
+	})
+
+	tc := newCase(t, `"assertion":"Eq"`)
+	t.Cleanup(tc.assert)
+
+	Eq(tc, 1, 2, Sprint("extra"))
+
+	lines := strings.Split(strings.TrimSpace(tc.capture), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected single line of output, got %q", tc.capture)
+	}
+
+	var result struct {
+		File        string
+		Line        int
+		Assertion   string
+		Message     string
+		Expected    string
+		Actual      string
+		PostScripts []struct {
+			Label   string
+			Content string
+		} `json:"post_scripts"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &result); err != nil {
+		t.Fatalf("expected valid json, got %v", err)
+	}
+	switch {
+	case result.File != "report_test.go" || result.Line == 0:
+		t.Fatalf("unexpected location %s:%d", result.File, result.Line)
+	case result.Message != "expected equality via cmp.Equal function":
+		t.Fatalf("unexpected message %q", result.Message)
+	case result.Expected != "1" || result.Actual != "2":
+		t.Fatalf("unexpected values %s and %s", result.Expected, result.Actual)
+	case len(result.PostScripts) != 1 || result.PostScripts[0].Label != "annotation":
+		t.Fatalf("unexpected post scripts %v", result.PostScripts)
+	}
+}
+
+func TestSetOutput_jsonGroup(t *testing.T) {
+	previous := SetOutput(OutputJSON)
+	t.Cleanup(func() {
+		SetReporter(previous)
+	})
+
+	tc := newCase(t, `"assertion":"Nil"`)
+	t.Cleanup(tc.assert)
+
+	Group(tc, func(g T) {
+		True(g, false)
+		Nil(g, 1)
+	})
+
+	lines := strings.Split(strings.TrimSpace(tc.capture), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two lines of output, got %q", tc.capture)
+	}
+	for _, line := range lines {
+		if !json.Valid([]byte(line)) {
+			t.Fatalf("expected valid json, got %q", line)
+		}
+	}
+}
+
+func TestSetOutput_text(t *testing.T) {
+	previous := SetOutput(OutputText)
+	t.Cleanup(func() {
+		SetReporter(previous)
+	})
+
+	tc := newCase(t, `↪ Assertion | differential ↷`)
+	t.Cleanup(tc.assert)
+
+	Eq(tc, 1, 2)
+}
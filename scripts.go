@@ -6,19 +6,9 @@ package test
 import (
 	"fmt"
 	"strings"
-)
 
-func run(posts ...PostScript) string {
-	s := new(strings.Builder)
-	for _, post := range posts {
-		s.WriteString("↪ PostScript | ")
-		s.WriteString(post.Label())
-		s.WriteString(" ↷\n")
-		s.WriteString(post.Content())
-		s.WriteString("\n")
-	}
-	return s.String()
-}
+	"github.com/shoenig/test/internal/assertions"
+)
 
 // A PostScript is used to annotate a test failure with additional information.
 //
@@ -94,3 +84,25 @@ func Func(f func() string) Setting {
 		})
 	}
 }
+
+type stack struct {
+	pcs []uintptr
+}
+
+func (s *stack) Label() string {
+	return "stack"
+}
+
+func (s *stack) Content() string {
+	return "\t" + strings.ReplaceAll(strings.TrimSpace(assertions.FormatStack(s.pcs)), "\n", "\n\t")
+}
+
+// Stack appends the stack trace of the goroutine making the assertion as an
+// annotation to the output of a test case failure. Frames of the test and must
+// packages, of any packages registered with RegisterHelpers, and of the Go
+// runtime and testing packages are omitted.
+func Stack() Setting {
+	return func(s *Settings) {
+		s.postScripts = append(s.postScripts, &stack{pcs: assertions.Stack()})
+	}
+}
@@ -20,6 +20,15 @@ apply test.go
 apply test_test.go
 apply examples_test.go
 apply examples_unix_test.go
+apply group.go
+apply group_test.go
+apply eventually.go
+apply eventually_test.go
+apply matchers.go
+apply matchers_test.go
+apply report.go
+apply report_test.go
+apply golden.go
 
 cp -R testdata must/
 
@@ -10,11 +10,18 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"testing/fstest"
 	"time"
 
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/shoenig/test/internal/assertions"
 	"github.com/shoenig/test/util"
 	"github.com/shoenig/test/wait"
 )
@@ -226,6 +233,27 @@ func TestEq(t *testing.T) {
 		Eq(tc, "foo", "bar")
 	})
 
+	t.Run("multi-line string", func(t *testing.T) {
+		tc := newCase(t, "@@ -1,3 +1,3 @@\n one\n-two\n+TWO\n three")
+		t.Cleanup(tc.assert)
+
+		Eq(tc, "one\ntwo\nthree\n", "one\nTWO\nthree\n")
+	})
+
+	t.Run("multi-line bytes", func(t *testing.T) {
+		tc := newCase(t, "@@ -1,2 +1,2 @@\n-one\n+ONE\n two")
+		t.Cleanup(tc.assert)
+
+		Eq(tc, []byte("one\ntwo"), []byte("ONE\ntwo"))
+	})
+
+	t.Run("lines", func(t *testing.T) {
+		tc := newCase(t, "--- exp\n+++ val\n@@ -1,1 +1,1 @@\n-foo\n+bar")
+		t.Cleanup(tc.assert)
+
+		Eq(tc, "foo", "bar", Lines())
+	})
+
 	t.Run("duration", func(t *testing.T) {
 		tc := newCase(t, `expected equality via cmp.Equal function`)
 		t.Cleanup(tc.assert)
@@ -297,6 +325,278 @@ func TestEqFunc_PS(t *testing.T) {
 	}, tc.TestPostScript("eq func"))
 }
 
+type podContainer struct {
+	Name  string
+	Image string
+	Ports []int
+}
+
+type podSpec struct {
+	Replicas   *int
+	Containers []podContainer
+	Labels     map[string]string
+	Created    time.Time
+}
+
+type pod struct {
+	Name string
+	Spec podSpec
+}
+
+type link struct {
+	Name string
+	Next *link
+}
+
+func TestEqPartial(t *testing.T) {
+	three := 3
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	val := &pod{
+		Name: "web",
+		Spec: podSpec{
+			Replicas: &three,
+			Containers: []podContainer{
+				{Name: "init", Image: "busybox"},
+				{Name: "app", Image: "app:1.0", Ports: []int{8080}},
+				{Name: "sidecar", Image: "envoy:1.2"},
+			},
+			Labels:  map[string]string{"app": "web", "tier": "frontend"},
+			Created: created,
+		},
+	}
+
+	t.Run("zero fields ignored", func(t *testing.T) {
+		tc := newCase(t, `expected partial equality`)
+		t.Cleanup(tc.assertNot)
+
+		exp := &pod{Spec: podSpec{
+			Replicas:   &three,
+			Containers: []podContainer{{}, {Ports: []int{8080}}, {Image: "envoy:1.2"}},
+			Labels:     map[string]string{"app": "web"},
+			Created:    created,
+		}}
+		EqPartial(tc, exp, val)
+	})
+
+	t.Run("mismatch paths", func(t *testing.T) {
+		tc := newCase(t, `expected partial equality via cmp.Equal function
+↪ .Name: exp "api", val "web"
+↪ .Spec.Containers[2].Image: exp "envoy:1.3", val "envoy:1.2"
+↪ .Spec.Labels["app"]: exp "api", val "web"
+↪ .Spec.Labels["zone"]: missing from val`)
+		t.Cleanup(tc.assert)
+
+		exp := &pod{Name: "api", Spec: podSpec{
+			Containers: []podContainer{{}, {}, {Image: "envoy:1.3"}},
+			Labels:     map[string]string{"zone": "us", "app": "api"},
+		}}
+		EqPartial(tc, exp, val)
+	})
+
+	t.Run("length", func(t *testing.T) {
+		tc := newCase(t, `.Spec.Containers: exp len(1), val len(3)`)
+		t.Cleanup(tc.assert)
+
+		exp := &pod{Spec: podSpec{Containers: []podContainer{{Name: "init"}}}}
+		EqPartial(tc, exp, val)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		tc := newCase(t, `.Spec.Replicas: exp 3, val nil`)
+		t.Cleanup(tc.assert)
+
+		exp := &pod{Spec: podSpec{Replicas: &three}}
+		EqPartial(tc, exp, &pod{Name: "web"})
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		tc := newCase(t, `expected partial equality`)
+		t.Cleanup(tc.assertNot)
+
+		exp := &pod{Name: "other", Spec: podSpec{
+			Containers: []podContainer{{}, {Image: "app:1.0"}},
+			Labels:     map[string]string{"app": "web"},
+		}}
+		EqPartial(tc, exp, val, Fields(".Spec.Containers[1].Image", `.Spec.Labels["app"]`, ".Spec.Containers[0].Ports"))
+	})
+
+	t.Run("fields mismatch", func(t *testing.T) {
+		tc := newCase(t, `.Spec.Containers[0].Name: exp "", val "init"`)
+		t.Cleanup(tc.assert)
+
+		exp := &pod{Spec: podSpec{Containers: []podContainer{{}}}}
+		EqPartial(tc, exp, val, Fields(".Spec.Containers[0].Name"))
+	})
+
+	t.Run("fields missing", func(t *testing.T) {
+		tc := newCase(t, `.Spec.Containers[5].Name: not in exp: .Spec.Containers[5]: index out of range (len 0)`)
+		t.Cleanup(tc.assert)
+
+		EqPartial(tc, &pod{}, val, Fields(".Spec.Containers[5].Name"))
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		tc := newCase(t, `["b"]["c"]: exp 2, val 3`)
+		t.Cleanup(tc.assert)
+
+		exp := map[string]any{"a": 1, "b": map[string]any{"c": 2}}
+		act := map[string]any{"a": 1, "b": map[string]any{"c": 3, "d": 4}, "e": 5}
+		EqPartial(tc, exp, act)
+	})
+
+	t.Run("max diffs", func(t *testing.T) {
+		tc := newCase(t, "↪ [1]: exp 1, val -1\n↪ … 2 more")
+		t.Cleanup(tc.assert)
+
+		EqPartial(tc, []int{0, 1, 2, 3}, []int{0, -1, -2, -3}, MaxDiffs(1))
+	})
+
+	t.Run("unlimited diffs", func(t *testing.T) {
+		tc := newCase(t, "↪ [1]: exp 1, val -1\n↪ [2]: exp 2, val -2\n↪ [3]: exp 3, val -3")
+		t.Cleanup(tc.assert)
+
+		EqPartial(tc, []int{0, 1, 2, 3}, []int{0, -1, -2, -3}, MaxDiffs(0))
+	})
+
+	t.Run("numeric keys", func(t *testing.T) {
+		tc := newCase(t, "↪ [9]: exp 1, val 2\n↪ [10]: exp 1, val 2\n↪ [100]: exp 1, val 2")
+		t.Cleanup(tc.assert)
+
+		EqPartial(tc, map[int]int{100: 1, 10: 1, 9: 1}, map[int]int{100: 2, 10: 2, 9: 2})
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		tc := newCase(t, `.Next.Name: exp "b", val "c"`)
+		t.Cleanup(tc.assert)
+
+		exp, val := &link{Name: "a"}, &link{Name: "a"}
+		exp.Next, val.Next = &link{Name: "b", Next: exp}, &link{Name: "c", Next: val}
+		EqPartial(tc, exp, val)
+	})
+}
+
+func TestPath(t *testing.T) {
+	three := 3
+	v := &pod{
+		Name: "web",
+		Spec: podSpec{
+			Replicas:   &three,
+			Containers: []podContainer{{Name: "app", Image: "app:1.0", Ports: []int{80, 443}}},
+			Labels:     map[string]string{"app": "web"},
+		},
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, `expected equality at path`)
+		t.Cleanup(tc.assertNot)
+
+		Path(tc, v, "Spec.Containers[0].Image", "app:1.0")
+		Path(tc, v, ".Spec.Containers[0].Ports[1]", 443)
+		Path(tc, v, `.Spec.Labels["app"]`, "web")
+		Path(tc, v, ".Spec.Labels[app]", "web")
+		Path(tc, v, ".Spec.Replicas", &three)
+		Path(tc, v, "Name", "web")
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		tc := newCase(t, "↪ path: Spec.Containers[0].Image\n↪ Assertion | differential ↷")
+		t.Cleanup(tc.assert)
+
+		Path(tc, v, "Spec.Containers[0].Image", "app:2.0")
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		tc := newCase(t, "↪ path: Spec.Containers[1].Image\n↪ error: .Spec.Containers[1]: index out of range (len 1)")
+		t.Cleanup(tc.assert)
+
+		Path(tc, v, "Spec.Containers[1].Image", "app:1.0")
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		tc := newCase(t, `error: .Spec.Replicas: is nil`)
+		t.Cleanup(tc.assert)
+
+		type wrapper struct {
+			Spec *struct{ Replicas *struct{ Count int } }
+		}
+		Path(tc, &wrapper{Spec: new(struct{ Replicas *struct{ Count int } })}, "Spec.Replicas.Count", 1)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		tc := newCase(t, "↪ path: Spec.Containers[0].Ports[0]\n↪ type: int")
+		t.Cleanup(tc.assert)
+
+		Path(tc, v, "Spec.Containers[0].Ports[0]", int64(80))
+	})
+
+	t.Run("interfaces", func(t *testing.T) {
+		tc := newCase(t, `expected equality at path`)
+		t.Cleanup(tc.assertNot)
+
+		doc := map[string]any{"items": []any{map[string]any{"id": 7}}}
+		Path(tc, doc, `items[0].id`, 7)
+		Path[any](tc, doc, `items[0].id`, 7)
+	})
+}
+
+func TestPathExists(t *testing.T) {
+	v := &pod{Spec: podSpec{Labels: map[string]string{"app": "web"}}}
+
+	t.Run("exists", func(t *testing.T) {
+		tc := newCase(t, `expected path to exist`)
+		t.Cleanup(tc.assertNot)
+
+		PathExists(tc, v, `Spec.Labels["app"]`)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		tc := newCase(t, `error: .Spec.Labels["tier"]: no such key`)
+		t.Cleanup(tc.assert)
+
+		PathExists(tc, v, `Spec.Labels["tier"]`)
+	})
+
+	t.Run("no field", func(t *testing.T) {
+		tc := newCase(t, `error: .Spec.Owner: no exported field "Owner" in`)
+		t.Cleanup(tc.assert)
+
+		PathExists(tc, v, `Spec.Owner.Name`)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		tc := newCase(t, `error: path "Spec.Labels[\"app": malformed key`)
+		t.Cleanup(tc.assert)
+
+		PathExists(tc, v, `Spec.Labels["app`)
+	})
+}
+
+func TestPathNotExists(t *testing.T) {
+	v := &pod{Spec: podSpec{Labels: map[string]string{"app": "web"}}}
+
+	t.Run("not exists", func(t *testing.T) {
+		tc := newCase(t, `expected path to not exist`)
+		t.Cleanup(tc.assertNot)
+
+		PathNotExists(tc, v, `Spec.Labels["tier"]`)
+		PathNotExists(tc, v, `Spec.Containers[0]`)
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		tc := newCase(t, "↪ path: Spec.Labels[\"app\"]")
+		t.Cleanup(tc.assert)
+
+		PathNotExists(tc, v, `Spec.Labels["app"]`)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		tc := newCase(t, `expected valid path`)
+		t.Cleanup(tc.assert)
+
+		PathNotExists(tc, v, `Spec..Labels`)
+	})
+}
+
 func TestNotEq(t *testing.T) {
 	tc := newCase(t, `expected inequality via cmp.Equal function`)
 	t.Cleanup(tc.assert)
@@ -369,6 +669,13 @@ func TestEqJSON(t *testing.T) {
 	EqJSON(tc, `{"a":1, "b":2}`, `{"b":2, "a":9}`)
 }
 
+func TestEqJSON_lines(t *testing.T) {
+	tc := newCase(t, "-  \"a\": 1,\n+  \"a\": 9,\n   \"b\": 2")
+	t.Cleanup(tc.assert)
+
+	EqJSON(tc, `{"a":1, "b":2}`, `{"b":2, "a":9}`)
+}
+
 func TestEqJSON_PS(t *testing.T) {
 	tc := newCapture(t)
 	t.Cleanup(tc.post)
@@ -376,6 +683,120 @@ func TestEqJSON_PS(t *testing.T) {
 	EqJSON(tc, `"one"`, `"two"`, tc.TestPostScript("eq json"))
 }
 
+const orderJSON = `{
+  "id": 42,
+  "customer": {"name": "Alice", "email": "alice@example.com"},
+  "items": [
+    {"sku": "A-1", "qty": 2, "tags": ["red", "small"]},
+    {"sku": "B-2", "qty": 1, "tags": []}
+  ],
+  "content-type": "order",
+  "paid": true
+}`
+
+func TestJSONPath(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, `expected equality at JSON path`)
+		t.Cleanup(tc.assertNot)
+
+		JSONPath(tc, orderJSON, "$.id", 42)
+		JSONPath(tc, orderJSON, "$.items[0].sku", "A-1")
+		JSONPath(tc, orderJSON, "$.items[0].tags", []string{"red", "small"})
+		JSONPath(tc, orderJSON, `$["content-type"]`, "order")
+		JSONPath(tc, orderJSON, "$.customer", map[string]string{"name": "Alice", "email": "alice@example.com"})
+		JSONPath(tc, orderJSON, "customer.name", "Alice")
+		JSONPath(tc, orderJSON, "$.paid", true)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		tc := newCase(t, "↪ path: $.items[1].qty\n↪ Assertion | differential ↷")
+		t.Cleanup(tc.assert)
+
+		JSONPath(tc, orderJSON, "$.items[1].qty", 3)
+	})
+
+	t.Run("lines", func(t *testing.T) {
+		tc := newCase(t, "--- exp\n+++ val\n@@ -1,4 +1,4 @@\n {\n-  \"email\": \"bob@example.com\",\n-  \"name\": \"Bob\"\n+  \"email\": \"alice@example.com\",\n+  \"name\": \"Alice\"\n }")
+		t.Cleanup(tc.assert)
+
+		JSONPath(tc, orderJSON, "$.customer", map[string]string{"name": "Bob", "email": "bob@example.com"})
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		tc := newCase(t, "↪ path: $.items[2].sku\n↪ error: $.items[2]: index out of range (len 2)")
+		t.Cleanup(tc.assert)
+
+		JSONPath(tc, orderJSON, "$.items[2].sku", "C-3")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tc := newCase(t, `failed to unmarshal document as JSON`)
+		t.Cleanup(tc.assert)
+
+		JSONPath(tc, `{"a":`, "$.a", 1)
+	})
+}
+
+func TestJSONContains(t *testing.T) {
+	t.Run("subset", func(t *testing.T) {
+		tc := newCase(t, `expected JSON to contain subset`)
+		t.Cleanup(tc.assertNot)
+
+		JSONContains(tc, orderJSON, `{"id": 42, "customer": {"name": "Alice"}}`)
+		JSONContains(tc, orderJSON, `{"items": [{"sku": "A-1"}, {}]}`)
+	})
+
+	t.Run("mismatches", func(t *testing.T) {
+		tc := newCase(t, `↪ $["content-type"]: exp "invoice", val "order"
+↪ $.customer.name: exp "Bob", val "Alice"
+↪ $.customer.phone: missing from val
+↪ $.items[1].qty: exp 5, val 1`)
+		t.Cleanup(tc.assert)
+
+		JSONContains(tc, orderJSON, `{
+			"customer": {"name": "Bob", "phone": "555"},
+			"items": [{}, {"qty": 5}],
+			"content-type": "invoice"
+		}`)
+	})
+
+	t.Run("array length", func(t *testing.T) {
+		tc := newCase(t, `$.items: exp 1 elements, val 2 elements`)
+		t.Cleanup(tc.assert)
+
+		JSONContains(tc, orderJSON, `{"items": [{"sku": "A-1"}]}`)
+	})
+
+	t.Run("ordered", func(t *testing.T) {
+		tc := newCase(t, `$.items[0].sku: exp "B-2", val "A-1"`)
+		t.Cleanup(tc.assert)
+
+		JSONContains(tc, orderJSON, `{"items": [{"sku": "B-2"}, {"sku": "A-1"}]}`)
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		tc := newCase(t, `expected JSON to contain subset`)
+		t.Cleanup(tc.assertNot)
+
+		JSONContains(tc, orderJSON, `{"items": [{"sku": "B-2"}, {"tags": ["small", "red"]}]}`, UnorderedArrays())
+		JSONContains(tc, `[{"a": 1, "b": 2}, {"a": 1}]`, `[{"a": 1}, {"a": 1, "b": 2}]`, UnorderedArrays())
+	})
+
+	t.Run("unordered mismatch", func(t *testing.T) {
+		tc := newCase(t, `$.items[1]: no matching element in val for {"sku":"A-1"}`)
+		t.Cleanup(tc.assert)
+
+		JSONContains(tc, orderJSON, `{"items": [{"qty": 2}, {"sku": "A-1"}]}`, UnorderedArrays())
+	})
+
+	t.Run("type", func(t *testing.T) {
+		tc := newCase(t, `$.customer: exp array, val {"email":"alice@example.com","name":"Alice"}`)
+		t.Cleanup(tc.assert)
+
+		JSONContains(tc, orderJSON, `{"customer": []}`)
+	})
+}
+
 func TestValidJSON(t *testing.T) {
 	tc := newCapture(t)
 	t.Cleanup(tc.assert)
@@ -433,6 +854,49 @@ func TestSliceEqFunc(t *testing.T) {
 		exp := []string{"Alice", "Carl"}
 		SliceEqFunc(tc, exp, values, (*Person).NameEquals)
 	})
+
+	t.Run("changed", func(t *testing.T) {
+		tc := newCase(t, "changed: [250]\n↪ Assertion | differential ↷\n--- exp\n+++ val\n@@ 247 equal elements @@\n  [247] 247\n  [248] 248\n  [249] 249\n- [250] 250\n+ [250] -1\n  [251] 251\n  [252] 252\n  [253] 253\n@@ 246 equal elements @@")
+		t.Cleanup(tc.assert)
+
+		a := make([]int, 500)
+		b := make([]int, 500)
+		for i := range a {
+			a[i], b[i] = i, i
+		}
+		b[250] = -1
+		SliceEqFunc(tc, a, b, func(a, b int) bool {
+			return a == b
+		})
+	})
+
+	t.Run("shifted", func(t *testing.T) {
+		tc := newCase(t, "deleted: exp[4]\n↪ inserted: val[0]\n↪ Assertion | differential ↷\n--- exp\n+++ val\n+ [0] 9\n  [0] 0\n  [1] 1\n  [2] 2\n  [3] 3\n- [4] 4")
+		t.Cleanup(tc.assert)
+
+		a := []int{0, 1, 2, 3, 4}
+		b := []int{9, 0, 1, 2, 3}
+		SliceEqFunc(tc, a, b, func(a, b int) bool {
+			return a == b
+		})
+	})
+
+	t.Run("large", func(t *testing.T) {
+		tc := newCase(t, "changed: [0], [49999]")
+		t.Cleanup(tc.assert)
+
+		// differs at both ends, where memory must not grow as the product
+		// of the lengths of the slices
+		a := make([]int, 50_000)
+		b := make([]int, 50_000)
+		for i := range a {
+			a[i], b[i] = i, i
+		}
+		b[0], b[len(b)-1] = -1, -2
+		SliceEqFunc(tc, a, b, func(a, b int) bool {
+			return a == b
+		})
+	})
 }
 
 func TestSliceEqFunc_PS(t *testing.T) {
@@ -538,6 +1002,43 @@ func TestSliceEqual(t *testing.T) {
 
 		SliceEqual(tc, a, b)
 	})
+
+	t.Run("indexes", func(t *testing.T) {
+		tc := newCase(t, `changed: [2]`)
+		t.Cleanup(tc.assert)
+
+		a := []*Person{
+			{ID: 100, Name: "Alice"},
+			{ID: 101, Name: "Bob"},
+			{ID: 102, Name: "Carl"},
+		}
+		b := []*Person{
+			{ID: 100, Name: "Alice"},
+			{ID: 101, Name: "Bob"},
+			{ID: 103, Name: "Dian"},
+			{ID: 104, Name: "Eve"},
+		}
+
+		SliceEqual(tc, a, b)
+	})
+
+	t.Run("receiver", func(t *testing.T) {
+		tc := newCase(t, ``)
+		t.Cleanup(tc.assertNot)
+
+		// the Equal method of exp elements is used, which need not be symmetric
+		a := []stem{"ab", "cd"}
+		b := []stem{"abc", "cde"}
+		SliceEqual(tc, a, b)
+	})
+}
+
+// stem implements an asymmetric Equal function, matching any word it is a
+// prefix of.
+type stem string
+
+func (s stem) Equal(o stem) bool {
+	return strings.HasPrefix(string(o), string(s))
 }
 
 func TestSliceEqOp(t *testing.T) {
@@ -560,6 +1061,92 @@ func TestSliceEqOp(t *testing.T) {
 	})
 }
 
+func TestSliceEqUnordered(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, `expected slices of same elements in any order`)
+		t.Cleanup(tc.assertNot)
+
+		a := []*Person{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}
+		b := []*Person{{ID: 2, Name: "Bob"}, {ID: 1, Name: "Alice"}}
+		SliceEqUnordered(tc, a, b)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		tc := newCase(t, `Person{ID:2, Name:"Bob"}`)
+		t.Cleanup(tc.assert)
+
+		a := []*Person{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}
+		b := []*Person{{ID: 1, Name: "Alice"}}
+		SliceEqUnordered(tc, a, b)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		tc := newCase(t, `expected slices of same elements in any order`)
+		t.Cleanup(tc.assertNot)
+
+		a := []*Person{{ID: 1, Name: "Alice"}}
+		b := []*Person{{ID: 1, Name: "Carl"}}
+		SliceEqUnordered(tc, a, b, Cmp(cmpopts.IgnoreFields(Person{}, "Name")))
+	})
+}
+
+func TestSliceEqUnorderedFunc(t *testing.T) {
+	eq := func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, `expected slices of same elements in any order`)
+		t.Cleanup(tc.assertNot)
+
+		SliceEqUnorderedFunc(tc, []string{"a", "B", "b"}, []string{"b", "A", "b"}, eq)
+	})
+
+	t.Run("extra", func(t *testing.T) {
+		tc := newCase(t, `extra: "c"`)
+		t.Cleanup(tc.assert)
+
+		SliceEqUnorderedFunc(tc, []string{"a", "b"}, []string{"b", "A", "c"}, eq)
+	})
+}
+
+func TestSliceEqUnorderedOp(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, `expected slices of same elements in any order`)
+		t.Cleanup(tc.assertNot)
+
+		SliceEqUnorderedOp(tc, []int{3, 1, 2, 1}, []int{1, 1, 2, 3})
+	})
+
+	t.Run("message", func(t *testing.T) {
+		tc := newCase(t, `expected slices of same elements in any order via == operator`)
+		t.Cleanup(tc.assert)
+
+		SliceEqUnorderedOp(tc, []int{1, 2}, []int{2, 3})
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		tc := newCase(t, `missing: 4 (×2)`)
+		t.Cleanup(tc.assert)
+
+		SliceEqUnorderedOp(tc, []int{1, 4, 4}, []int{1})
+	})
+
+	t.Run("extra", func(t *testing.T) {
+		tc := newCase(t, `extra: 5`)
+		t.Cleanup(tc.assert)
+
+		SliceEqUnorderedOp(tc, []int{1}, []int{5, 1})
+	})
+
+	t.Run("count", func(t *testing.T) {
+		tc := newCase(t, `count: 2 exp 3, val 1`)
+		t.Cleanup(tc.assert)
+
+		SliceEqUnorderedOp(tc, []int{2, 2, 1, 2}, []int{1, 2})
+	})
+}
+
 func TestLesser(t *testing.T) {
 	tc := newCase(t, `expected val to be less via .Less method`)
 	t.Cleanup(tc.assert)
@@ -870,34 +1457,193 @@ func TestSliceContainsSubsetFunc(t *testing.T) {
 	SliceContainsSubsetFunc(tc, s, []string{"Bob", "Eve"}, (*Person).NameEquals)
 }
 
-func TestSliceContainsSubsetEqual(t *testing.T) {
-	tc := newCase(t, `expected slice to contain missing item via .Equal method`)
-	tc.exp = "Eve"
-	t.Cleanup(tc.assert)
+func TestSliceContainsSubsetEqual(t *testing.T) {
+	tc := newCase(t, `expected slice to contain missing item via .Equal method`)
+	tc.exp = "Eve"
+	t.Cleanup(tc.assert)
+
+	s := []*Person{
+		{ID: 100, Name: "Alice"},
+		{ID: 101, Name: "Bob"},
+		{ID: 103, Name: "Carl"},
+		{ID: 104, Name: "Dora"},
+	}
+
+	SliceContainsSubsetEqual(tc, s, []*Person{{ID: 101, Name: "Bob"}, {ID: 105, Name: "Eve"}})
+}
+
+func TestSliceContainsSubset(t *testing.T) {
+	tc := newCase(t, `expected slice to contain missing item`)
+	tc.exp = "Eve"
+	t.Cleanup(tc.assert)
+
+	s := []*Person{
+		{ID: 100, Name: "Alice"},
+		{ID: 101, Name: "Bob"},
+		{ID: 103, Name: "Carl"},
+		{ID: 104, Name: "Dora"},
+	}
+
+	SliceContainsSubset(tc, s, []*Person{{ID: 101, Name: "Bob"}, {ID: 105, Name: "Eve"}})
+}
+
+// naturals is an infinite sequence of natural numbers
+func naturals(yield func(int) bool) {
+	for i := 0; ; i++ {
+		if !yield(i) {
+			return
+		}
+	}
+}
+
+func TestSeqEq(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		SeqEq(tc, []int{1, 2, 3}, slices.Values([]int{1, 2, 3}))
+	})
+	t.Run("different length", func(t *testing.T) {
+		tc := newCase(t, `expected sequence of same length`)
+		t.Cleanup(tc.assert)
+
+		SeqEq(tc, []int{1, 2, 3}, slices.Values([]int{1, 2}))
+	})
+	t.Run("different items", func(t *testing.T) {
+		tc := newCase(t, "expected sequence equality via cmp.Equal function\n↪ exp: []int{1, 2, 3}\n↪ seq: slices.Values([]int{1, 5, 3})\n↪ changed: [1]")
+		t.Cleanup(tc.assert)
+		t.Cleanup(func() {
+			if !strings.Contains(tc.capture, "- [1] 2\n+ [1] 5\n") {
+				t.Fatalf("expected element diff in output, got %q", tc.capture)
+			}
+		})
+
+		SeqEq(tc, []int{1, 2, 3}, slices.Values([]int{1, 5, 3}))
+	})
+	t.Run("infinite", func(t *testing.T) {
+		tc := newCase(t, `expected sequence to end within 100 items`)
+		t.Cleanup(tc.assert)
+
+		SeqEq(tc, []int{1, 2, 3}, naturals, MaxItems(100))
+	})
+	t.Run("unlimited items", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		exp := make([]int, DefaultMaxItems+1)
+		SeqEq(tc, exp, slices.Values(exp), MaxItems(0))
+	})
+}
+
+func TestSeq2Eq(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Seq2Eq(tc, slices.All([]string{"a", "b"}), slices.All([]string{"a", "b"}))
+	})
+	t.Run("different items", func(t *testing.T) {
+		tc := newCase(t, `expected sequence equality via cmp.Equal function`)
+		t.Cleanup(tc.assert)
+
+		Seq2Eq(tc, slices.All([]string{"a", "b"}), slices.All([]string{"a", "c"}))
+	})
+	t.Run("different length", func(t *testing.T) {
+		tc := newCase(t, "expected sequence of same length")
+		t.Cleanup(tc.assert)
+		t.Cleanup(func() {
+			if !strings.Contains(tc.capture, "↪ inserted: val[2]\n") || !strings.Contains(tc.capture, "+ [2] 2: \"d\"") {
+				t.Fatalf("expected element diff of pairs in output, got %q", tc.capture)
+			}
+		})
+
+		Seq2Eq(tc, slices.All([]string{"a", "b"}), slices.All([]string{"a", "b", "d"}))
+	})
+}
+
+func TestSeqLen(t *testing.T) {
+	t.Run("same length", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		SeqLen(tc, 3, slices.Values([]int{1, 2, 3}))
+	})
+	t.Run("different length", func(t *testing.T) {
+		tc := newCase(t, `expected sequence to be different length`)
+		t.Cleanup(tc.assert)
+
+		SeqLen(tc, 2, slices.Values([]int{1, 2, 3}))
+	})
+	t.Run("infinite", func(t *testing.T) {
+		tc := newCase(t, `expected sequence to end within 10000 items`)
+		t.Cleanup(tc.assert)
+
+		SeqLen(tc, 2, naturals)
+	})
+}
+
+func TestSeqEmpty(t *testing.T) {
+	tc := newCase(t, `expected sequence to be empty`)
+	t.Cleanup(tc.assert)
+
+	SeqEmpty(tc, naturals)
+}
+
+func TestSeqContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		SeqContains(tc, naturals, 42)
+	})
+	t.Run("missing", func(t *testing.T) {
+		tc := newCase(t, `expected sequence to contain missing item via cmp.Equal method`)
+		t.Cleanup(tc.assert)
+
+		SeqContains(tc, slices.Values([]int{1, 2, 3}), 4)
+	})
+	t.Run("infinite", func(t *testing.T) {
+		tc := newCase(t, `expected sequence to end within 10 items`)
+		t.Cleanup(tc.assert)
+
+		SeqContains(tc, naturals, -1, MaxItems(10))
+	})
+}
+
+func TestSeqContainsAll(t *testing.T) {
+	t.Run("contains all", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
 
-	s := []*Person{
-		{ID: 100, Name: "Alice"},
-		{ID: 101, Name: "Bob"},
-		{ID: 103, Name: "Carl"},
-		{ID: 104, Name: "Dora"},
-	}
+		SeqContainsAll(tc, slices.Values([]int{1, 2, 3}), []int{3, 1, 2})
+	})
+	t.Run("missing", func(t *testing.T) {
+		tc := newCase(t, `sequence is missing 4`)
+		t.Cleanup(tc.assert)
 
-	SliceContainsSubsetEqual(tc, s, []*Person{{ID: 101, Name: "Bob"}, {ID: 105, Name: "Eve"}})
+		SeqContainsAll(tc, slices.Values([]int{1, 2, 3}), []int{3, 4, 2})
+	})
+	t.Run("different length", func(t *testing.T) {
+		tc := newCase(t, `expected sequence and items to contain same number of elements`)
+		t.Cleanup(tc.assert)
+
+		SeqContainsAll(tc, slices.Values([]int{1, 2, 3}), []int{3, 2})
+	})
 }
 
-func TestSliceContainsSubset(t *testing.T) {
-	tc := newCase(t, `expected slice to contain missing item`)
-	tc.exp = "Eve"
-	t.Cleanup(tc.assert)
+func TestSeqAscending(t *testing.T) {
+	t.Run("ascending", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
 
-	s := []*Person{
-		{ID: 100, Name: "Alice"},
-		{ID: 101, Name: "Bob"},
-		{ID: 103, Name: "Carl"},
-		{ID: 104, Name: "Dora"},
-	}
+		SeqAscending(tc, slices.Values([]int{1, 2, 2, 3}))
+	})
+	t.Run("not ascending", func(t *testing.T) {
+		tc := newCase(t, `expected item[1] <= item[2]`)
+		t.Cleanup(tc.assert)
 
-	SliceContainsSubset(tc, s, []*Person{{ID: 101, Name: "Bob"}, {ID: 105, Name: "Eve"}})
+		SeqAscending(tc, slices.Values([]int{1, 3, 2}))
+	})
 }
 
 func TestPositive(t *testing.T) {
@@ -1361,6 +2107,38 @@ func TestMapEq(t *testing.T) {
 		b := custom2{"key": 2}
 		MapEq(tc, a, b)
 	})
+
+	t.Run("all differences", func(t *testing.T) {
+		tc := newCase(t, "expected maps of same keys\n↪ exp: a\n↪ val: b\n↪ missing keys: \"a\"\n↪ extra keys: \"e\"\n↪ different values: \"c\", \"d\"\n↪ Assertion | differential ↷\n--- exp\n+++ val\n- \"a\": 1\n- \"c\": 3\n+ \"c\": 30\n- \"d\": 4\n+ \"d\": 40\n+ \"e\": 5")
+		t.Cleanup(tc.assert)
+		a := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+		b := map[string]int{"b": 2, "c": 30, "d": 40, "e": 5}
+		MapEq(tc, a, b)
+	})
+
+	t.Run("value diff", func(t *testing.T) {
+		tc := newCase(t, "+++ val\n@@ 2 @@\n")
+		t.Cleanup(tc.assert)
+		a := map[int]Person{1: {ID: 1, Name: "Alice"}, 2: {ID: 2, Name: "Bob"}}
+		b := map[int]Person{1: {ID: 1, Name: "Alice"}, 2: {ID: 2, Name: "Bob B."}}
+		MapEq(tc, a, b)
+	})
+
+	t.Run("max diffs", func(t *testing.T) {
+		tc := newCase(t, "↪ different values: 1, 2, … 2 more\n↪ Assertion | differential ↷\n--- exp\n+++ val\n- 1: 1\n+ 1: -1\n- 2: 2\n+ 2: -2\n@@ 2 more differences @@")
+		t.Cleanup(tc.assert)
+		a := map[int]int{4: 4, 3: 3, 2: 2, 1: 1}
+		b := map[int]int{4: -4, 3: -3, 2: -2, 1: -1}
+		MapEq(tc, a, b, MaxDiffs(2))
+	})
+
+	t.Run("unlimited diffs", func(t *testing.T) {
+		tc := newCase(t, "↪ different values: 1, 2, 3, 4\n↪ Assertion | differential ↷\n--- exp\n+++ val\n- 1: 1\n+ 1: -1\n- 2: 2\n+ 2: -2\n- 3: 3\n+ 3: -3\n- 4: 4\n+ 4: -4")
+		t.Cleanup(tc.assert)
+		a := map[int]int{4: 4, 3: 3, 2: 2, 1: 1}
+		b := map[int]int{4: -4, 3: -3, 2: -2, 1: -1}
+		MapEq(tc, a, b, MaxDiffs(-1))
+	})
 }
 
 func TestMapEqFunc(t *testing.T) {
@@ -1382,6 +2160,18 @@ func TestMapEqFunc(t *testing.T) {
 			return p1.ID == p2.ID && p1.Name == p2.Name
 		})
 	})
+
+	t.Run("different keys", func(t *testing.T) {
+		tc := newCase(t, "missing keys: 1, 3\n↪ extra keys: 2")
+		t.Cleanup(tc.assert)
+
+		a := map[int]string{1: "one", 3: "three"}
+		b := map[int]string{2: "two"}
+
+		MapEqFunc(tc, a, b, func(s1, s2 string) bool {
+			return s1 == s2
+		})
+	})
 }
 
 func TestMapEqual(t *testing.T) {
@@ -1935,6 +2725,182 @@ func TestFileContains(t *testing.T) {
 	})
 }
 
+func TestGolden(t *testing.T) {
+	t.Run("content matches", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Golden(tc, "TestGolden/greeting", []byte("hello\nworld\n"))
+	})
+	t.Run("content differs", func(t *testing.T) {
+		tc := newCase(t, `expected content to match golden file`)
+		t.Cleanup(tc.assert)
+		t.Cleanup(func() {
+			for _, exp := range []string{"--- golden", "+++ got", "@@ -1,2 +1,2 @@", " hello", "-world", "+there"} {
+				if !strings.Contains(tc.capture, exp) {
+					t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+				}
+			}
+		})
+
+		Golden(tc, "TestGolden/greeting", []byte("hello\nthere\n"))
+	})
+	t.Run("file does not exist", func(t *testing.T) {
+		tc := newCase(t, `expected to read golden file`)
+		t.Cleanup(tc.assert)
+
+		Golden(tc, "TestGolden/missing", []byte("hello\n"))
+	})
+	t.Run("normalize content", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Golden(tc, "TestGolden/greeting", []byte("hello  \r\nworld\t\r\n"), NormalizeLineEndings(), TrimTrailingSpace())
+	})
+	t.Run("update", func(t *testing.T) {
+		t.Setenv(UpdateGoldenEnvVar, "1")
+		t.Chdir(t.TempDir())
+
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Golden(tc, "TestGolden/created", []byte("new content\n"))
+		b, err := os.ReadFile(filepath.Join("testdata", "TestGolden", "created.golden"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "new content\n" {
+			t.Fatalf("unexpected golden file content %q", b)
+		}
+	})
+}
+
+func TestGoldenJSON(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	t.Run("content matches", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		GoldenJSON(tc, "TestGoldenJSON/person", person{Name: "alice", Age: 42})
+	})
+	t.Run("content differs", func(t *testing.T) {
+		tc := newCase(t, `+  "Age": 43`)
+		t.Cleanup(tc.assert)
+
+		GoldenJSON(tc, "TestGoldenJSON/person", person{Name: "alice", Age: 43})
+	})
+	t.Run("not encodable", func(t *testing.T) {
+		tc := newCase(t, `expected to encode value as json`)
+		t.Cleanup(tc.assert)
+
+		GoldenJSON(tc, "TestGoldenJSON/person", make(chan int))
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Run("content matches", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Snapshot(tc, "hello\nworld", Inline(`
+hello
+world
+`))
+	})
+	t.Run("content differs", func(t *testing.T) {
+		tc := newCase(t, `expected value to match inline snapshot`)
+		t.Cleanup(tc.assert)
+		t.Cleanup(func() {
+			for _, exp := range []string{"--- snapshot", "+++ got", "-world", "+there"} {
+				if !strings.Contains(tc.capture, exp) {
+					t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+				}
+			}
+		})
+
+		Snapshot(tc, "hello\nthere", Inline(`
+hello
+world
+`))
+	})
+	t.Run("go syntax", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Snapshot(tc, map[string]int{"one": 1}, Inline(`map[string]int{"one":1}`))
+	})
+	t.Run("normalize content", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Snapshot(tc, []byte("a \r\nb"), Inline("a\nb"), NormalizeLineEndings(), TrimTrailingSpace())
+	})
+	t.Run("trailing newline", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		Snapshot(tc, "hello\nworld\n", Inline(`
+hello
+world
+
+`))
+	})
+	t.Run("whitespace differs", func(t *testing.T) {
+		tc := newCase(t, `expected value to match inline snapshot`)
+		t.Cleanup(tc.assert)
+
+		Snapshot(tc, "  hello\n", Inline(`hello`))
+	})
+}
+
+func TestSnapshot_rewrite(t *testing.T) {
+	source := `package example
+
+func TestExample(t *testing.T) {
+	test.Snapshot(t, got, test.Inline(` + "`old`" + `))
+	test.Snapshot(t, got, test.Inline("one"))
+	test.Snapshot(t, got, test.Inline("two"))
+}
+`
+	file := filepath.Join(t.TempDir(), "example_test.go")
+	if err := os.WriteFile(file, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// lines as compiled, before any rewrite
+	if err := assertions.RewriteInline(file, 4, "first\nsecond"); err != nil {
+		t.Fatal(err)
+	}
+	if err := assertions.RewriteInline(file, 5, "new"); err != nil {
+		t.Fatal(err)
+	}
+	if err := assertions.RewriteInline(file, 6, "  padded\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := assertions.RewriteInline(file, 3, "missing"); err == nil {
+		t.Fatal("expected error rewriting line without snapshot")
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `package example
+
+func TestExample(t *testing.T) {
+	test.Snapshot(t, got, test.Inline(` + "`\nfirst\nsecond\n`" + `))
+	test.Snapshot(t, got, test.Inline(` + "`new`" + `))
+	test.Snapshot(t, got, test.Inline(` + "`\n  padded\n\n`" + `))
+}
+`
+	if string(b) != exp {
+		t.Fatalf("unexpected rewritten source %q", b)
+	}
+}
+
 func TestFilePathValid(t *testing.T) {
 	tc := newCase(t, `expected valid file path`)
 	t.Cleanup(tc.assert)
@@ -1979,6 +2945,13 @@ func TestStrContains(t *testing.T) {
 	StrContains(tc, "banana", "band")
 }
 
+func TestStrContains_multiline(t *testing.T) {
+	tc := newCase(t, "string ↷\n1 | apple\n2 | banana")
+	t.Cleanup(tc.assert)
+
+	StrContains(tc, "apple\nbanana", "band")
+}
+
 func TestStrContainsFold(t *testing.T) {
 	tc := newCase(t, `expected string to contain substring; it does not`)
 	t.Cleanup(tc.assert)
@@ -2250,6 +3223,284 @@ func TestWait_TestFunc(t *testing.T) {
 	))
 }
 
+func TestWait_History(t *testing.T) {
+	t.Run("initial", func(t *testing.T) {
+		tc := newCase(t, `↪ attempts: 9
+↪ elapsed:`)
+		t.Cleanup(tc.assert)
+		t.Cleanup(func() {
+			for _, exp := range []string{
+				"↪ distinct errors: 8\n",
+				"↪   [attempt 1] fail 1\n",
+				"↪   [attempt 3] fail 3\n",
+				"↪   … 2 more\n",
+				"↪   [attempt 6] fail 6\n",
+				"↪   [attempt 9] fail 8",
+			} {
+				if !strings.Contains(tc.capture, exp) {
+					t.Fatalf("expected %q in output, got %q", exp, tc.capture)
+				}
+			}
+			if strings.Contains(tc.capture, "[attempt 4]") || strings.Contains(tc.capture, "held:") {
+				t.Fatalf("expected elided history, got %q", tc.capture)
+			}
+		})
+
+		i := 0
+		Wait(tc, wait.InitialSuccess(
+			wait.ErrorFunc(func() error {
+				i++
+				return errors.New("fail " + strconv.Itoa(min(i, 8)))
+			}),
+			wait.Attempts(8),
+			wait.Gap(1*time.Millisecond),
+		))
+	})
+
+	t.Run("continual", func(t *testing.T) {
+		tc := newCase(t, `↪ held:`)
+		t.Cleanup(tc.assert)
+
+		i := 0
+		Wait(tc, wait.ContinualSuccess(
+			wait.BoolFunc(func() bool {
+				i++
+				return i < 3
+			}),
+			wait.Attempts(5),
+			wait.Gap(1*time.Millisecond),
+		))
+	})
+}
+
+func blocked(ch chan struct{}) {
+	<-ch
+}
+
+var blockedName = runtime.FuncForPC(reflect.ValueOf(blocked).Pointer()).Name()
+
+func TestNoGoroutineLeaks(t *testing.T) {
+	t.Run("no leaks", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		NoGoroutineLeaks(tc)
+		done := make(chan struct{})
+		go func() { close(done) }()
+		<-done
+	})
+	t.Run("settles", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		NoGoroutineLeaks(tc)
+		go func() { time.Sleep(50 * time.Millisecond) }()
+	})
+	t.Run("leaked", func(t *testing.T) {
+		tc := newCase(t, `expected no leaked goroutines; found 2 after 100ms`)
+		t.Cleanup(tc.assert)
+		t.Cleanup(func() {
+			if !strings.Contains(tc.capture, "2 × goroutine ↷\ngithub.com/shoenig/test") {
+				t.Fatalf("expected grouped stacks in output, got %q", tc.capture)
+			}
+		})
+
+		ch := make(chan struct{})
+		t.Cleanup(func() { close(ch) })
+		NoGoroutineLeaks(tc, LeakTimeout(100*time.Millisecond))
+		for range 2 {
+			go blocked(ch)
+		}
+	})
+	t.Run("ignore top function", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		ch := make(chan struct{})
+		t.Cleanup(func() { close(ch) })
+		NoGoroutineLeaks(tc, LeakTimeout(100*time.Millisecond), IgnoreTopFunction(blockedName))
+		go blocked(ch)
+	})
+	t.Run("ignore stack matching", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		ch := make(chan struct{})
+		t.Cleanup(func() { close(ch) })
+		NoGoroutineLeaks(tc, LeakTimeout(100*time.Millisecond), IgnoreStackMatching(regexp.MustCompile(`\.blocked\(`)))
+		go blocked(ch)
+	})
+	t.Run("settles timers", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		// e.g. the goroutine cancelling a context upon its deadline
+		NoGoroutineLeaks(tc)
+		started := make(chan struct{})
+		time.AfterFunc(0, func() {
+			close(started)
+			time.Sleep(50 * time.Millisecond)
+		})
+		<-started
+	})
+	t.Run("leaked timer", func(t *testing.T) {
+		tc := newCase(t, `expected no leaked goroutines; found 1 after 100ms`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan struct{})
+		t.Cleanup(func() { close(ch) })
+		NoGoroutineLeaks(tc, LeakTimeout(100*time.Millisecond))
+		started := make(chan struct{})
+		time.AfterFunc(0, func() {
+			close(started)
+			blocked(ch)
+		})
+		<-started
+	})
+}
+
+func TestReceives(t *testing.T) {
+	t.Run("receives", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		ch := make(chan int)
+		go func() { ch <- 42 }()
+		Receives(tc, ch, 42, time.Second)
+	})
+	t.Run("different value", func(t *testing.T) {
+		tc := newCase(t, `expected received value equality via cmp.Equal function`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int, 1)
+		ch <- 41
+		Receives(tc, ch, 42, time.Second)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		tc := newCase(t, `expected to receive value within 10ms`)
+		t.Cleanup(tc.assert)
+
+		Receives(tc, make(chan int), 42, 10*time.Millisecond)
+	})
+	t.Run("closed", func(t *testing.T) {
+		tc := newCase(t, `expected to receive value; channel is closed`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int)
+		close(ch)
+		Receives(tc, ch, 42, time.Second)
+	})
+}
+
+func TestReceivesWithin(t *testing.T) {
+	t.Run("receives", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		ch := make(chan string, 1)
+		ch <- "hello"
+		ReceivesWithin(tc, ch, time.Second)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		tc := newCase(t, `expected to receive value within 10ms`)
+		t.Cleanup(tc.assert)
+
+		ReceivesWithin(tc, make(chan string), 10*time.Millisecond)
+	})
+}
+
+func TestNoReceive(t *testing.T) {
+	t.Run("no value", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		NoReceive(tc, make(chan int), 10*time.Millisecond)
+	})
+	t.Run("received", func(t *testing.T) {
+		tc := newCase(t, `expected to receive no value within 1s`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int)
+		go func() { ch <- 7 }()
+		NoReceive(tc, ch, time.Second)
+	})
+	t.Run("closed", func(t *testing.T) {
+		tc := newCase(t, `expected to receive no value; channel is closed`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int)
+		close(ch)
+		NoReceive(tc, ch, time.Second)
+	})
+}
+
+func TestClosed(t *testing.T) {
+	t.Run("closed", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		ch := make(chan int)
+		go close(ch)
+		Closed(tc, ch, time.Second)
+	})
+	t.Run("received", func(t *testing.T) {
+		tc := newCase(t, `expected channel to be closed; received value`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int, 1)
+		ch <- 1
+		Closed(tc, ch, time.Second)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		tc := newCase(t, `expected channel to be closed within 10ms`)
+		t.Cleanup(tc.assert)
+
+		Closed(tc, make(chan int), 10*time.Millisecond)
+	})
+}
+
+func TestDrains(t *testing.T) {
+	t.Run("drains", func(t *testing.T) {
+		tc := newCase(t, "")
+		t.Cleanup(tc.assertNot)
+
+		ch := make(chan int)
+		go func() {
+			for i := range 3 {
+				ch <- i
+			}
+		}()
+		Drains(tc, ch, 3, time.Second)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		tc := newCase(t, `expected to receive 3 values within 10ms`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+		Drains(tc, ch, 3, 10*time.Millisecond)
+	})
+	t.Run("closed", func(t *testing.T) {
+		tc := newCase(t, `expected to receive 3 values; channel is closed`)
+		t.Cleanup(tc.assert)
+
+		ch := make(chan int, 2)
+		ch <- 1
+		close(ch)
+		Drains(tc, ch, 3, time.Second)
+	})
+}
+
+func TestChanLen(t *testing.T) {
+	tc := newCase(t, `expected channel to be different length`)
+	t.Cleanup(tc.assert)
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ChanLen(tc, 2, ch)
+}
+
 func TestStructEqual(t *testing.T) {
 	tc := newCase(t, `expected inequality via .Equal method`)
 	t.Cleanup(tc.assert)
@@ -0,0 +1,96 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package wait
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// A Pacer determines the amount of time to wait between the attempts made
+// under a Constraint.
+type Pacer interface {
+	// Next returns the amount of time to wait after the given attempt, where
+	// the first attempt is 1.
+	Next(attempt int) time.Duration
+}
+
+// PacerFunc is a function implementing Pacer.
+type PacerFunc func(attempt int) time.Duration
+
+// Next returns f(attempt).
+func (f PacerFunc) Next(attempt int) time.Duration {
+	return f(attempt)
+}
+
+// Pace sets the Pacer determining the amount of time to wait between attempts.
+//
+// If set, any other Pacer (e.g. Gap) is replaced.
+func Pace(p Pacer) Option {
+	return func(c *Constraint) {
+		c.pacer = p
+	}
+}
+
+// ExponentialBackoff sets the amount of time to wait between attempts to begin
+// at initial, and to be multiplied by factor after each attempt, up to max.
+//
+// If set, any other Pacer (e.g. Gap) is replaced.
+func ExponentialBackoff(initial, max time.Duration, factor float64) Option {
+	return Pace(PacerFunc(func(attempt int) time.Duration {
+		d := float64(initial) * math.Pow(factor, float64(attempt-1))
+		if d >= float64(max) {
+			return max
+		}
+		return time.Duration(d)
+	}))
+}
+
+// Fibonacci sets the amount of time to wait between attempts to follow the
+// Fibonacci sequence in units of initial (i.e. 1, 1, 2, 3, 5, ... × initial),
+// up to max. An initial amount of zero or less is raised to 1 millisecond, as
+// the sequence would otherwise never grow.
+//
+// If set, any other Pacer (e.g. Gap) is replaced.
+func Fibonacci(initial, max time.Duration) Option {
+	if initial <= 0 {
+		initial = time.Millisecond
+	}
+	return Pace(PacerFunc(func(attempt int) time.Duration {
+		// the sequence reaches max within a bounded number of steps, as it
+		// grows exponentially and saturates rather than overflows
+		a, b := initial, initial
+		for i := 1; i < attempt && a < max; i++ {
+			a, b = b, b+min(a, max-b)
+		}
+		return min(a, max)
+	}))
+}
+
+// Jitter randomizes the amount of time to wait between attempts by up to the
+// given fraction (between 0 and 1) of the amount set by the Pacer, more or
+// less, e.g. 0.1 waits anywhere between 90% and 110% of the amount.
+//
+// Default 0 (no jitter).
+func Jitter(fraction float64) Option {
+	return func(c *Constraint) {
+		c.jitter = min(max(fraction, 0), 1)
+	}
+}
+
+// constant is a Pacer waiting the same amount of time after every attempt.
+type constant time.Duration
+
+func (c constant) Next(int) time.Duration {
+	return time.Duration(c)
+}
+
+// jitter randomly adjusts d by up to fraction of d, more or less.
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction == 0 || d <= 0 {
+		return d
+	}
+	return d + time.Duration(float64(d)*fraction*(2*rand.Float64()-1))
+}
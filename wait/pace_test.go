@@ -0,0 +1,150 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package wait
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func pacerOf(opts ...Option) Pacer {
+	c := InitialSuccess(opts...)
+	return c.pacer
+}
+
+func sequence(p Pacer, n int) []time.Duration {
+	result := make([]time.Duration, 0, n)
+	for attempt := 1; attempt <= n; attempt++ {
+		result = append(result, p.Next(attempt))
+	}
+	return result
+}
+
+func TestPacer(t *testing.T) {
+	t.Parallel()
+
+	ms := time.Millisecond
+
+	cases := []struct {
+		name string
+		opt  Option
+		exp  []time.Duration
+	}{
+		{
+			name: "default",
+			opt:  func(*Constraint) {},
+			exp:  []time.Duration{250 * ms, 250 * ms, 250 * ms},
+		},
+		{
+			name: "gap",
+			opt:  Gap(10 * ms),
+			exp:  []time.Duration{10 * ms, 10 * ms, 10 * ms},
+		},
+		{
+			name: "exponential",
+			opt:  ExponentialBackoff(10*ms, 100*ms, 2),
+			exp:  []time.Duration{10 * ms, 20 * ms, 40 * ms, 80 * ms, 100 * ms, 100 * ms},
+		},
+		{
+			name: "fibonacci",
+			opt:  Fibonacci(10*ms, 100*ms),
+			exp:  []time.Duration{10 * ms, 10 * ms, 20 * ms, 30 * ms, 50 * ms, 80 * ms, 100 * ms, 100 * ms},
+		},
+		{
+			name: "fibonacci non-positive",
+			opt:  Fibonacci(-1, 5*ms),
+			exp:  []time.Duration{1 * ms, 1 * ms, 2 * ms, 3 * ms, 5 * ms, 5 * ms},
+		},
+		{
+			name: "custom",
+			opt: Pace(PacerFunc(func(attempt int) time.Duration {
+				return time.Duration(attempt) * ms
+			})),
+			exp: []time.Duration{1 * ms, 2 * ms, 3 * ms},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := sequence(pacerOf(tc.opt), len(tc.exp))
+			if !slices.Equal(tc.exp, result) {
+				t.Fatalf("exp: %v, got: %v", tc.exp, result)
+			}
+		})
+	}
+}
+
+func TestPacer_large(t *testing.T) {
+	t.Parallel()
+
+	p := pacerOf(Fibonacci(time.Second, time.Minute))
+	if d := p.Next(10_000); d != time.Minute {
+		t.Fatalf("exp: %v, got: %v", time.Minute, d)
+	}
+
+	p = pacerOf(Fibonacci(time.Nanosecond, math.MaxInt64))
+	if d := p.Next(math.MaxInt); d != math.MaxInt64 {
+		t.Fatalf("exp: %v, got: %v", time.Duration(math.MaxInt64), d)
+	}
+
+	p = pacerOf(ExponentialBackoff(time.Second, time.Minute, 1.5))
+	if d := p.Next(10_000); d != time.Minute {
+		t.Fatalf("exp: %v, got: %v", time.Minute, d)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+
+	d := 100 * time.Millisecond
+	for i := 0; i < 100; i++ {
+		j := jitter(d, 0.2)
+		if j < 80*time.Millisecond || j > 120*time.Millisecond {
+			t.Fatalf("jitter out of range: %v", j)
+		}
+	}
+
+	if j := jitter(d, 0); j != d {
+		t.Fatalf("exp: %v, got: %v", d, j)
+	}
+
+	c := InitialSuccess(Jitter(5))
+	if c.jitter != 1 {
+		t.Fatalf("exp jitter clamped to 1, got: %v", c.jitter)
+	}
+}
+
+func TestPace_runnables(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		ctor func(...Option) *Constraint
+		fn   Option
+		exp  []int
+	}{
+		{name: "bool initial", ctor: InitialSuccess, fn: BoolFunc(boolFnFalse), exp: []int{1, 2, 3, 4}},
+		{name: "bool continual", ctor: ContinualSuccess, fn: BoolFunc(boolFnTrue), exp: []int{1, 2, 3}},
+		{name: "error initial", ctor: InitialSuccess, fn: ErrorFunc(errFnNotNil), exp: []int{1, 2, 3, 4}},
+		{name: "error continual", ctor: ContinualSuccess, fn: ErrorFunc(errFnNil), exp: []int{1, 2, 3}},
+		{name: "test initial", ctor: InitialSuccess, fn: TestFunc(tFnNotNil), exp: []int{1, 2, 3, 4}},
+		{name: "test continual", ctor: ContinualSuccess, fn: TestFunc(tFnNil), exp: []int{1, 2, 3}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts []int
+			pacer := Pace(PacerFunc(func(attempt int) time.Duration {
+				attempts = append(attempts, attempt)
+				return time.Millisecond
+			}))
+			_ = tc.ctor(tc.fn, pacer, Attempts(4)).Run()
+			if !slices.Equal(tc.exp, attempts) {
+				t.Fatalf("exp: %v, got: %v", tc.exp, attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package wait
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// An Attempt records the outcome of one attempt made under a Constraint.
+type Attempt struct {
+	// Number is the position of the attempt within its run, starting from 1.
+	Number int
+
+	// Start is when the attempt was made.
+	Start time.Time
+
+	// Duration is how long the attempt took, or until it was abandoned.
+	Duration time.Duration
+
+	// OK is whether the attempt produced a positive result.
+	OK bool
+
+	// Err is the error produced by the attempt, if any.
+	Err error
+}
+
+// kept is the number of the first and of the last attempts of a run kept by
+// its Report.
+const kept = 50
+
+// A Report describes the attempts made during the latest run of a Constraint.
+type Report struct {
+	// Continual is whether the Constraint is of ContinualSuccess.
+	Continual bool
+
+	// Start is when the run began.
+	Start time.Time
+
+	// Elapsed is how long the run took, or has taken so far.
+	Elapsed time.Duration
+
+	// Attempts contains the first and the last 50 attempts made, in order. The
+	// Number of each attempt indicates its position among all attempts made.
+	Attempts []Attempt
+
+	// Total is the number of attempts made.
+	Total int
+
+	// Failures is the number of attempts made with a negative result.
+	Failures int
+
+	// Err is the error result of the run, if any.
+	Err error
+
+	streak time.Time     // start of the current run of positive attempts
+	held   time.Duration // of the latest run of positive attempts, once ended
+}
+
+// add records attempt a, discarding the oldest of the last attempts kept if
+// there are too many.
+func (r *Report) add(a Attempt) {
+	r.Total++
+	a.Number = r.Total
+	if a.OK {
+		if r.streak.IsZero() {
+			r.streak = a.Start
+		}
+	} else {
+		r.Failures++
+		r.held = 0
+		if !r.streak.IsZero() {
+			r.held = a.Start.Sub(r.streak)
+		}
+		r.streak = time.Time{}
+	}
+	if len(r.Attempts) == 2*kept {
+		r.Attempts = slices.Delete(r.Attempts, kept, kept+1)
+	}
+	r.Attempts = append(r.Attempts, a)
+}
+
+// Distinct returns the attempt which most recently produced each distinct error,
+// in order of the attempts. Errors are distinct by their message. Only the kept
+// Attempts are considered.
+func (r Report) Distinct() []Attempt {
+	seen := make(map[string]bool)
+	var result []Attempt
+	for i := len(r.Attempts) - 1; i >= 0; i-- {
+		a := r.Attempts[i]
+		if a.Err == nil || seen[a.Err.Error()] {
+			continue
+		}
+		seen[a.Err.Error()] = true
+		result = append(result, a)
+	}
+	slices.Reverse(result)
+	return result
+}
+
+// Held returns how long the condition was last observed to be true, i.e. from
+// the first of the latest consecutive positive attempts up to the negative
+// attempt which followed them, or up to the end of the run.
+func (r Report) Held() time.Duration {
+	if !r.streak.IsZero() {
+		return r.Start.Add(r.Elapsed).Sub(r.streak)
+	}
+	return r.held
+}
+
+// String renders the history of attempts in r, e.g. for logging.
+func (r Report) String() string {
+	s := new(strings.Builder)
+	fmt.Fprintf(s, "wait: %d attempts over %s", r.Total, r.Elapsed.Round(time.Millisecond))
+	if r.Err != nil {
+		fmt.Fprintf(s, ": %v", r.Err)
+	}
+	s.WriteString("\n")
+	previous := 0
+	for _, a := range r.Attempts {
+		if omitted := a.Number - previous - 1; omitted > 0 {
+			fmt.Fprintf(s, "  … %d attempts omitted\n", omitted)
+		}
+		previous = a.Number
+		offset := a.Start.Sub(r.Start).Round(time.Millisecond)
+		fmt.Fprintf(s, "  #%d at +%s took %s: ", a.Number, offset, a.Duration.Round(time.Microsecond))
+		switch {
+		case a.OK:
+			s.WriteString("ok\n")
+		case a.Err != nil:
+			fmt.Fprintf(s, "failed: %v\n", a.Err)
+		default:
+			s.WriteString("failed\n")
+		}
+	}
+	return s.String()
+}
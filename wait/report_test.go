@@ -0,0 +1,194 @@
+// Copyright (c) The Test Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReport_Initial(t *testing.T) {
+	t.Parallel()
+
+	i := 0
+	c := InitialSuccess(
+		ErrorFunc(func() error {
+			i++
+			if i == 4 {
+				return nil
+			}
+			return fmt.Errorf("attempt %d", min(i, 2))
+		}),
+		Gap(1*time.Millisecond),
+	)
+	eqErr(t, nil, c.Run())
+
+	r := c.Report()
+	if r.Continual {
+		t.Fatal("expected initial report")
+	}
+	if n := len(r.Attempts); n != 4 {
+		t.Fatalf("expected 4 attempts, got %d", n)
+	}
+	for i, a := range r.Attempts {
+		if a.Number != i+1 {
+			t.Fatalf("expected attempt number %d, got %d", i+1, a.Number)
+		}
+		if ok := i == 3; a.OK != ok {
+			t.Fatalf("expected attempt %d ok %t", a.Number, ok)
+		}
+	}
+	if r.Elapsed <= 0 {
+		t.Fatalf("expected elapsed time, got %s", r.Elapsed)
+	}
+
+	distinct := r.Distinct()
+	if n := len(distinct); n != 2 {
+		t.Fatalf("expected 2 distinct errors, got %d", n)
+	}
+	if distinct[0].Number != 1 || distinct[1].Number != 3 {
+		t.Fatalf("expected latest occurrences, got %d and %d", distinct[0].Number, distinct[1].Number)
+	}
+	if r.Total != 4 || r.Failures != 3 {
+		t.Fatalf("expected 4 attempts of which 3 failed, got %d and %d", r.Total, r.Failures)
+	}
+}
+
+func TestReport_Continual(t *testing.T) {
+	t.Parallel()
+
+	i := 0
+	c := ContinualSuccess(
+		BoolFunc(func() bool {
+			i++
+			return i != 5
+		}),
+		Attempts(10),
+		Gap(5*time.Millisecond),
+	)
+	eqErr(t, ErrConditionUnsatisfied, c.Run())
+
+	r := c.Report()
+	if !r.Continual {
+		t.Fatal("expected continual report")
+	}
+	if n := len(r.Attempts); n != 5 {
+		t.Fatalf("expected 5 attempts, got %d", n)
+	}
+	if !errors.Is(r.Err, ErrConditionUnsatisfied) {
+		t.Fatalf("expected run error, got %v", r.Err)
+	}
+	if held := r.Held(); held < 15*time.Millisecond {
+		t.Fatalf("expected condition held for at least 15ms, got %s", held)
+	}
+}
+
+func TestReport_Held(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	at := func(ms int) time.Time {
+		return start.Add(time.Duration(ms) * time.Millisecond)
+	}
+
+	cases := []struct {
+		name     string
+		attempts []Attempt
+		exp      time.Duration
+	}{
+		{name: "none", exp: 0},
+		{
+			name:     "never",
+			attempts: []Attempt{{Start: at(0)}, {Start: at(10)}},
+			exp:      0,
+		},
+		{
+			name:     "until failure",
+			attempts: []Attempt{{Start: at(0)}, {Start: at(10), OK: true}, {Start: at(20), OK: true}, {Start: at(30)}},
+			exp:      20 * time.Millisecond,
+		},
+		{
+			name:     "until end",
+			attempts: []Attempt{{Start: at(0)}, {Start: at(10), OK: true}},
+			exp:      90 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := Report{Start: start, Elapsed: 100 * time.Millisecond}
+			for _, a := range tc.attempts {
+				r.add(a)
+			}
+			if held := r.Held(); held != tc.exp {
+				t.Fatalf("exp: %s, held: %s", tc.exp, held)
+			}
+		})
+	}
+}
+
+func TestReport_Bounded(t *testing.T) {
+	t.Parallel()
+
+	c := ContinualSuccess(
+		BoolFunc(boolFnTrue),
+		Attempts(1000),
+		Gap(0),
+	)
+	eqErr(t, nil, c.Run())
+
+	r := c.Report()
+	if r.Total != 1000 {
+		t.Fatalf("expected 1000 attempts, got %d", r.Total)
+	}
+	if n := len(r.Attempts); n != 2*kept {
+		t.Fatalf("expected %d kept attempts, got %d", 2*kept, n)
+	}
+	if first, last := r.Attempts[0].Number, r.Attempts[len(r.Attempts)-1].Number; first != 1 || last != 1000 {
+		t.Fatalf("expected first and last attempts, got %d and %d", first, last)
+	}
+	if number := r.Attempts[kept].Number; number != 1000-kept+1 {
+		t.Fatalf("expected last attempts from %d, got %d", 1000-kept+1, number)
+	}
+	if s := r.String(); !strings.Contains(s, "  … 900 attempts omitted\n") {
+		t.Fatalf("expected omitted attempts in report, got %q", s)
+	}
+}
+
+func TestReport_String(t *testing.T) {
+	t.Parallel()
+
+	c := InitialSuccess(
+		ErrorFunc(errFnNotNil),
+		Attempts(2),
+		Gap(1*time.Millisecond),
+	)
+	eqErr(t, fmt.Errorf("%s: %w", ErrAttemptsExceeded.Error(), oops), c.Run())
+
+	r := c.Report()
+	s := r.String()
+	for _, exp := range []string{"attempts over", "#1 at +", "#2 at +", "failed: oops"} {
+		if !strings.Contains(s, exp) {
+			t.Fatalf("expected %q in report, got %q", exp, s)
+		}
+	}
+}
+
+func TestReport_Reset(t *testing.T) {
+	t.Parallel()
+
+	c := InitialSuccess(
+		BoolFunc(boolFnTrue),
+	)
+	eqErr(t, nil, c.Run())
+	eqErr(t, nil, c.Run())
+
+	r := c.Report()
+	if n := len(r.Attempts); n != 1 {
+		t.Fatalf("expected attempts of latest run only, got %d", n)
+	}
+}
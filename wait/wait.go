@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
+	"sync"
 	"time"
 )
 
@@ -17,6 +19,7 @@ var (
 	ErrAttemptsExceeded     = errors.New("wait: attempts exceeded")
 	ErrConditionUnsatisfied = errors.New("wait: condition unsatisfied")
 	ErrNoFunction           = errors.New("wait: no function specified")
+	ErrAttemptTimeout       = errors.New("wait: attempt timeout exceeded")
 )
 
 const (
@@ -38,21 +41,34 @@ const (
 // the Constraint threshold is exceeded. If at any point the function returns a
 // negative result, an error is returned from Run().
 //
-// A Constraint threshold is configured via either Timeout or Attempts (not both).
+// A Constraint threshold is configured via Timeout, Attempts, or both, in which
+// case the threshold is exceeded by whichever is reached first.
 //
 // Timeout - Constraint is time bound.
 //
 // Attempts - Constraint is iteration bound.
 //
+// AttemptTimeout - each attempt is time bound.
+//
 // The use of Gap controls the pace of attempts by setting the amount of time to
-// wait in between each attempt.
+// wait in between each attempt. Alternatively ExponentialBackoff, Fibonacci, or
+// any Pacer set by Pace increase the time between attempts as they are made,
+// and Jitter randomizes the time between attempts.
 type Constraint struct {
 	continual  bool // (initial || continual) success
 	now        time.Time
 	deadline   time.Time
-	gap        time.Duration
+	pacer      Pacer
+	jitter     float64
 	iterations int
+	timed      bool // Timeout is set
+	counted    bool // Attempts is set
+	slow       time.Duration
+	ctx        context.Context
 	r          runnable
+
+	lock   sync.Mutex
+	report Report // of the latest run
 }
 
 // InitialSuccess creates a new Constraint configured by opts that will wait for a
@@ -62,10 +78,10 @@ type Constraint struct {
 //
 // Timeout is used to set a maximum amount of time to wait for success.
 // Attempts is used to set a maximum number of attempts to wait for success.
-// Gap is used to control the amount of time to wait between retries.
+// Gap (or a Pacer) is used to control the amount of time to wait between retries.
 //
-// One of ErrorFunc, BoolFunc, or TestFunc represents the function that will
-// be run under the constraint.
+// One of ErrorFunc, BoolFunc, or TestFunc (or their Context variants) represents
+// the function that will be run under the constraint.
 func InitialSuccess(opts ...Option) *Constraint {
 	c := &Constraint{now: time.Now()}
 	c.setup(opts...)
@@ -79,10 +95,10 @@ func InitialSuccess(opts ...Option) *Constraint {
 //
 // Timeout is used to set the amount of time to assert success.
 // Attempts is used to set the number of iterations to assert success.
-// Gap is used to control the amount of time to wait between iterations.
+// Gap (or a Pacer) is used to control the amount of time to wait between iterations.
 //
-// One of ErrorFunc, BoolFunc, or TestFunc represents the function that will
-// be run under the constraint.
+// One of ErrorFunc, BoolFunc, or TestFunc (or their Context variants) represents
+// the function that will be run under the constraint.
 func ContinualSuccess(opts ...Option) *Constraint {
 	c := &Constraint{now: time.Now(), continual: true}
 	c.setup(opts...)
@@ -91,39 +107,72 @@ func ContinualSuccess(opts ...Option) *Constraint {
 
 // Timeout sets a time bound on a Constraint.
 //
-// If set, the Attempts constraint configuration is disabled.
+// If Attempts is also set, the Constraint is exceeded by whichever bound is
+// reached first.
 //
-// Default 3 seconds.
+// Default 3 seconds, unless Attempts is set.
 func Timeout(duration time.Duration) Option {
 	return func(c *Constraint) {
 		c.deadline = time.Now().Add(duration)
-		c.iterations = math.MaxInt
+		c.timed = true
 	}
 }
 
 // Attempts sets an iteration bound on a Constraint.
 //
-// If set, the Timeout constraint configuration is disabled.
+// If Timeout is also set, the Constraint is exceeded by whichever bound is
+// reached first.
 //
 // By default a Timeout constraint is set and the Attempts bound is disabled.
 func Attempts(max int) Option {
 	return func(c *Constraint) {
 		c.iterations = max
-		c.deadline = time.Date(9999, 0, 0, 0, 0, 0, 0, time.UTC)
+		c.counted = true
+	}
+}
+
+// AttemptTimeout sets a time bound on each attempt of a Constraint. A function
+// still running once its attempt times out is abandoned (though its context is
+// done), and the attempt counts as a failure with ErrAttemptTimeout.
+//
+// By default attempts are not time bound.
+func AttemptTimeout(duration time.Duration) Option {
+	return func(c *Constraint) {
+		c.slow = duration
 	}
 }
 
-// Gap sets the amount of time to wait between attempts.
+// Gap sets a fixed amount of time to wait between attempts.
+//
+// If set, any other Pacer is replaced.
 //
 // Default 250 milliseconds.
 func Gap(duration time.Duration) Option {
+	return Pace(constant(duration))
+}
+
+// Context sets the context of a Constraint, which aborts waiting once ctx is
+// done, e.g. the context of a test from testing.T.Context.
+//
+// Default context.Background.
+func Context(ctx context.Context) Option {
 	return func(c *Constraint) {
-		c.gap = duration
+		c.ctx = ctx
 	}
 }
 
 // BoolFunc executes f under the thresholds of a Constraint.
 func BoolFunc(f func() bool) Option {
+	return BoolContextFunc(func(context.Context) bool {
+		return f()
+	})
+}
+
+// BoolContextFunc executes f under the thresholds of a Constraint, passing f
+// the context of each attempt, which is done once the Constraint is exceeded
+// or its context is done. Under ContinualSuccess, reaching the Timeout of the
+// Constraint does not make the context of an attempt done.
+func BoolContextFunc(f func(context.Context) bool) Option {
 	return func(c *Constraint) {
 		if c.continual {
 			c.r = boolFuncContinual(f)
@@ -135,25 +184,107 @@ func BoolFunc(f func() bool) Option {
 
 // Option is used to configure a Constraint.
 //
-// Understood Option functions include Timeout, Attempts, Gap, InitialSuccess,
-// and ContinualSuccess.
+// Understood Option functions include Timeout, Attempts, Gap, Pace,
+// ExponentialBackoff, Fibonacci, Jitter, Context, InitialSuccess, and
+// ContinualSuccess.
 type Option func(*Constraint)
 
 type runnable func(*runner) *result
 
 type runner struct {
 	c        *Constraint
+	ctx      context.Context
 	attempts int
 }
 
+// attempt makes an attempt of f within ctx, recording its outcome as judged by
+// judge. If the attempt times out before f returns, f is abandoned and the
+// abandoned value is returned instead. If instead the run of a continual
+// Constraint is exceeded before f returns, f is abandoned and the expired value
+// (i.e. a positive result) is returned, as f did not fail within the run.
+func attempt[T any](r *runner, ctx context.Context, f func(context.Context) T, abandoned, expired T, judge func(T) (bool, error)) (v T) {
+	start := time.Now()
+	defer func() {
+		ok, err := judge(v)
+		r.c.record(Attempt{Start: start, Duration: time.Since(start), OK: ok, Err: err})
+	}()
+
+	// an attempt of a continual Constraint is not bound by the run deadline,
+	// as reaching the deadline is the success of the run
+	parent := ctx
+	if r.c.continual {
+		parent = r.ctx
+	}
+
+	if r.c.slow <= 0 {
+		actx, cancel := context.WithCancel(parent)
+		defer cancel()
+		return f(actx)
+	}
+
+	actx, cancel := context.WithTimeout(parent, r.c.slow)
+	defer cancel()
+
+	done := make(chan T, 1)
+	go func() {
+		done <- f(actx)
+	}()
+
+	var exceeded <-chan struct{}
+	if r.c.continual {
+		exceeded = ctx.Done()
+	}
+
+	select {
+	case v = <-done:
+		return v
+	case <-exceeded:
+	case <-actx.Done():
+	}
+	if r.c.continual && ctx.Err() != nil && r.ctx.Err() == nil {
+		return expired
+	}
+	return abandoned
+}
+
+func judgeBool(ok bool) (bool, error) {
+	return ok, nil
+}
+
+func judgeError(err error) (bool, error) {
+	return err == nil, err
+}
+
+func judgeOutcome(o outcome) (bool, error) {
+	return o.ok, o.err
+}
+
+// outcome is the result of a function given to TestFunc.
+type outcome struct {
+	ok  bool
+	err error
+}
+
+// gap returns the amount of time to wait after the latest attempt.
+func (r *runner) gap() time.Duration {
+	return jitter(r.c.pacer.Next(r.attempts), r.c.jitter)
+}
+
+// canceled returns the error of the context given to Run, if it is done.
+func (r *runner) canceled() error {
+	if err := r.ctx.Err(); err != nil {
+		return fmt.Errorf("wait: %w", err)
+	}
+	return nil
+}
+
 type result struct {
 	Err error
 }
 
-func boolFuncContinual(f func() bool) runnable {
-	bg := context.Background()
+func boolFuncContinual(f func(context.Context) bool) runnable {
 	return func(r *runner) *result {
-		ctx, cancel := context.WithDeadline(bg, r.c.deadline)
+		ctx, cancel := context.WithDeadline(r.ctx, r.c.deadline)
 		defer cancel()
 
 		timer := time.NewTimer(0)
@@ -161,7 +292,8 @@ func boolFuncContinual(f func() bool) runnable {
 
 		for {
 			// make an attempt
-			if !f() {
+			ok := attempt(r, ctx, f, false, true, judgeBool)
+			if !ok {
 				return &result{Err: ErrConditionUnsatisfied}
 			}
 
@@ -174,12 +306,12 @@ func boolFuncContinual(f func() bool) runnable {
 			}
 
 			// reset timer to gap interval
-			timer.Reset(r.c.gap)
+			timer.Reset(r.gap())
 
 			// wait for gap or time
 			select {
 			case <-ctx.Done():
-				return &result{Err: nil}
+				return &result{Err: r.canceled()}
 			case <-timer.C:
 				// continue
 			}
@@ -187,10 +319,9 @@ func boolFuncContinual(f func() bool) runnable {
 	}
 }
 
-func boolFuncInitial(f func() bool) runnable {
-	bg := context.Background()
+func boolFuncInitial(f func(context.Context) bool) runnable {
 	return func(r *runner) *result {
-		ctx, cancel := context.WithDeadline(bg, r.c.deadline)
+		ctx, cancel := context.WithDeadline(r.ctx, r.c.deadline)
 		defer cancel()
 
 		timer := time.NewTimer(0)
@@ -198,7 +329,8 @@ func boolFuncInitial(f func() bool) runnable {
 
 		for {
 			// make an attempt
-			if f() {
+			ok := attempt(r, ctx, f, false, true, judgeBool)
+			if ok {
 				return &result{Err: nil}
 			}
 
@@ -211,11 +343,14 @@ func boolFuncInitial(f func() bool) runnable {
 			}
 
 			// reset timer to gap interval
-			timer.Reset(r.c.gap)
+			timer.Reset(r.gap())
 
 			// wait for gap or timeout
 			select {
 			case <-ctx.Done():
+				if err := r.canceled(); err != nil {
+					return &result{Err: err}
+				}
 				return &result{Err: ErrTimeoutExceeded}
 			case <-timer.C:
 				// continue
@@ -227,6 +362,17 @@ func boolFuncInitial(f func() bool) runnable {
 // ErrorFunc will retry f while it returns a non-nil error, or until a wait
 // constraint threshold is exceeded.
 func ErrorFunc(f func() error) Option {
+	return ContextFunc(func(context.Context) error {
+		return f()
+	})
+}
+
+// ContextFunc will retry f while it returns a non-nil error, or until a wait
+// constraint threshold is exceeded, passing f the context of each attempt,
+// which is done once the Constraint is exceeded or its context is done. Under
+// ContinualSuccess, reaching the Timeout of the Constraint does not make the
+// context of an attempt done.
+func ContextFunc(f func(context.Context) error) Option {
 	return func(c *Constraint) {
 		if c.continual {
 			c.r = errFuncContinual(f)
@@ -236,10 +382,9 @@ func ErrorFunc(f func() error) Option {
 	}
 }
 
-func errFuncContinual(f func() error) runnable {
-	bg := context.Background()
+func errFuncContinual(f func(context.Context) error) runnable {
 	return func(r *runner) *result {
-		ctx, cancel := context.WithDeadline(bg, r.c.deadline)
+		ctx, cancel := context.WithDeadline(r.ctx, r.c.deadline)
 		defer cancel()
 
 		timer := time.NewTimer(0)
@@ -247,7 +392,8 @@ func errFuncContinual(f func() error) runnable {
 
 		for {
 			// make an attempt
-			if err := f(); err != nil {
+			err := attempt(r, ctx, f, ErrAttemptTimeout, nil, judgeError)
+			if err != nil {
 				return &result{Err: err}
 			}
 
@@ -260,12 +406,12 @@ func errFuncContinual(f func() error) runnable {
 			}
 
 			// reset timer to gap interval
-			timer.Reset(r.c.gap)
+			timer.Reset(r.gap())
 
 			// wait for gap or time
 			select {
 			case <-ctx.Done():
-				return &result{Err: nil}
+				return &result{Err: r.canceled()}
 			case <-timer.C:
 				// continue
 			}
@@ -273,10 +419,9 @@ func errFuncContinual(f func() error) runnable {
 	}
 }
 
-func errFuncInitial(f func() error) runnable {
-	bg := context.Background()
+func errFuncInitial(f func(context.Context) error) runnable {
 	return func(r *runner) *result {
-		ctx, cancel := context.WithDeadline(bg, r.c.deadline)
+		ctx, cancel := context.WithDeadline(r.ctx, r.c.deadline)
 		defer cancel()
 
 		timer := time.NewTimer(0)
@@ -284,7 +429,7 @@ func errFuncInitial(f func() error) runnable {
 
 		for {
 			// make an attempt
-			err := f()
+			err := attempt(r, ctx, f, ErrAttemptTimeout, nil, judgeError)
 			if err == nil {
 				return &result{Err: nil}
 			}
@@ -300,11 +445,16 @@ func errFuncInitial(f func() error) runnable {
 			}
 
 			// reset timer to gap interval
-			timer.Reset(r.c.gap)
+			timer.Reset(r.gap())
 
 			// wait for gap or timeout
 			select {
 			case <-ctx.Done():
+				if canceled := r.canceled(); canceled != nil {
+					return &result{
+						Err: fmt.Errorf("%w: %w", canceled, err),
+					}
+				}
 				return &result{
 					Err: fmt.Errorf("%s: %w", ErrTimeoutExceeded.Error(), err),
 				}
@@ -319,6 +469,18 @@ func errFuncInitial(f func() error) runnable {
 // threshold is exceeded. If f never succeeds, the latest returned error is
 // wrapped into the result.
 func TestFunc(f func() (bool, error)) Option {
+	return TestContextFunc(func(context.Context) (bool, error) {
+		return f()
+	})
+}
+
+// TestContextFunc will retry f while it returns false, or until a wait
+// constraint threshold is exceeded, passing f the context of each attempt,
+// which is done once the Constraint is exceeded or its context is done. Under
+// ContinualSuccess, reaching the Timeout of the Constraint does not make the
+// context of an attempt done. If f never succeeds, the latest returned error is
+// wrapped into the result.
+func TestContextFunc(f func(context.Context) (bool, error)) Option {
 	return func(c *Constraint) {
 		if c.continual {
 			c.r = testFuncContinual(f)
@@ -328,10 +490,17 @@ func TestFunc(f func() (bool, error)) Option {
 	}
 }
 
-func testFuncContinual(f func() (bool, error)) runnable {
-	bg := context.Background()
+// test adapts f to return an outcome.
+func test(f func(context.Context) (bool, error)) func(context.Context) outcome {
+	return func(ctx context.Context) outcome {
+		ok, err := f(ctx)
+		return outcome{ok: ok, err: err}
+	}
+}
+
+func testFuncContinual(f func(context.Context) (bool, error)) runnable {
 	return func(r *runner) *result {
-		ctx, cancel := context.WithDeadline(bg, r.c.deadline)
+		ctx, cancel := context.WithDeadline(r.ctx, r.c.deadline)
 		defer cancel()
 
 		timer := time.NewTimer(0)
@@ -339,7 +508,8 @@ func testFuncContinual(f func() (bool, error)) runnable {
 
 		for {
 			// make an attempt
-			ok, err := f()
+			o := attempt(r, ctx, test(f), outcome{err: ErrAttemptTimeout}, outcome{ok: true}, judgeOutcome)
+			ok, err := o.ok, o.err
 			if !ok {
 				return &result{Err: fmt.Errorf("%s: %w", ErrConditionUnsatisfied.Error(), err)}
 			}
@@ -353,12 +523,12 @@ func testFuncContinual(f func() (bool, error)) runnable {
 			}
 
 			// reset timer to gap interval
-			timer.Reset(r.c.gap)
+			timer.Reset(r.gap())
 
 			// wait for gap or time
 			select {
 			case <-ctx.Done():
-				return &result{Err: nil}
+				return &result{Err: r.canceled()}
 			case <-timer.C:
 				// continue
 			}
@@ -366,10 +536,9 @@ func testFuncContinual(f func() (bool, error)) runnable {
 	}
 }
 
-func testFuncInitial(f func() (bool, error)) runnable {
-	bg := context.Background()
+func testFuncInitial(f func(context.Context) (bool, error)) runnable {
 	return func(r *runner) *result {
-		ctx, cancel := context.WithDeadline(bg, r.c.deadline)
+		ctx, cancel := context.WithDeadline(r.ctx, r.c.deadline)
 		defer cancel()
 
 		timer := time.NewTimer(0)
@@ -377,7 +546,8 @@ func testFuncInitial(f func() (bool, error)) runnable {
 
 		for {
 			// make an attempt
-			ok, err := f()
+			o := attempt(r, ctx, test(f), outcome{err: ErrAttemptTimeout}, outcome{ok: true}, judgeOutcome)
+			ok, err := o.ok, o.err
 			if ok {
 				return &result{Err: nil}
 			}
@@ -398,11 +568,16 @@ func testFuncInitial(f func() (bool, error)) runnable {
 			}
 
 			// reset timer to gap interval
-			timer.Reset(r.c.gap)
+			timer.Reset(r.gap())
 
 			// wait for gap or timeout
 			select {
 			case <-ctx.Done():
+				if canceled := r.canceled(); canceled != nil {
+					return &result{
+						Err: fmt.Errorf("%w: %w", canceled, err),
+					}
+				}
 				return &result{
 					Err: fmt.Errorf("%s: %w", ErrTimeoutExceeded.Error(), err),
 				}
@@ -415,20 +590,74 @@ func testFuncInitial(f func() (bool, error)) runnable {
 
 func (c *Constraint) setup(opts ...Option) {
 	for _, opt := range append([]Option{
-		Timeout(defaultTimeout),
 		Gap(defaultGap),
 	}, opts...) {
 		opt(c)
 	}
+
+	// a time bound applies by default, unless only an iteration bound is set
+	switch {
+	case !c.timed && !c.counted:
+		Timeout(defaultTimeout)(c)
+		c.iterations = math.MaxInt
+	case !c.timed:
+		c.deadline = time.Date(9999, 0, 0, 0, 0, 0, 0, time.UTC)
+	case !c.counted:
+		c.iterations = math.MaxInt
+	}
 }
 
 // Run the Constraint and produce an error result.
+//
+// Run is equivalent to RunContext with the context set by the Context option.
 func (c *Constraint) Run() error {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return c.RunContext(ctx)
+}
+
+// RunContext runs the Constraint and produces an error result, aborting once
+// ctx is done. The error result of an aborted Constraint wraps the error of ctx,
+// e.g. context.Canceled.
+func (c *Constraint) RunContext(ctx context.Context) error {
 	if c.r == nil {
 		return ErrNoFunction
 	}
-	return c.r(&runner{
+
+	c.lock.Lock()
+	c.report = Report{Continual: c.continual, Start: time.Now()}
+	c.lock.Unlock()
+
+	err := c.r(&runner{
 		c:        c,
+		ctx:      ctx,
 		attempts: 0,
 	}).Err
+
+	c.lock.Lock()
+	c.report.Elapsed = time.Since(c.report.Start)
+	c.report.Err = err
+	c.lock.Unlock()
+	return err
+}
+
+// Report returns the history of attempts made during the latest run of the
+// Constraint, e.g. for logging when a run produces an error.
+func (c *Constraint) Report() Report {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	r := c.report
+	r.Attempts = slices.Clone(r.Attempts)
+	if r.Elapsed == 0 && !r.Start.IsZero() {
+		r.Elapsed = time.Since(r.Start)
+	}
+	return r
+}
+
+func (c *Constraint) record(a Attempt) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.report.add(a)
 }
@@ -4,6 +4,7 @@
 package wait
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -368,3 +369,296 @@ func TestInitial_TestFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestInitial_Context(t *testing.T) {
+	t.Parallel()
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name string
+		opts []Option
+		exp  error
+	}{
+		{
+			name: "canceled bool",
+			opts: []Option{BoolFunc(boolFnFalse), Context(canceled)},
+			exp:  fmt.Errorf("wait: %w", context.Canceled),
+		},
+		{
+			name: "canceled error",
+			opts: []Option{ErrorFunc(errFnNotNil), Context(canceled)},
+			exp:  fmt.Errorf("wait: %w: %w", context.Canceled, oops),
+		},
+		{
+			name: "canceled test",
+			opts: []Option{TestFunc(tFnNotNil), Context(canceled)},
+			exp:  fmt.Errorf("wait: %w: %w", context.Canceled, oops),
+		},
+		{
+			name: "canceled but passes",
+			opts: []Option{BoolFunc(boolFnTrue), Context(canceled)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := InitialSuccess(tc.opts...)
+			err := c.Run()
+			eqErr(t, tc.exp, err)
+			if tc.exp != nil && !errors.Is(err, context.Canceled) {
+				t.Fatalf("exp: %v, err: %v", context.Canceled, err)
+			}
+		})
+	}
+}
+
+func TestContinual_Context(t *testing.T) {
+	t.Parallel()
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := ContinualSuccess(BoolFunc(boolFnTrue), Context(canceled))
+	err := c.Run()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("exp: %v, err: %v", context.Canceled, err)
+	}
+}
+
+func TestContinual_ContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	// honours the context of each attempt, which must not be done once the
+	// timeout of a continual constraint is reached
+	honours := func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(40 * time.Millisecond):
+			return nil
+		}
+	}
+
+	cases := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "error", opts: []Option{ContextFunc(honours)}},
+		{name: "bool", opts: []Option{BoolContextFunc(func(ctx context.Context) bool {
+			return honours(ctx) == nil
+		})}},
+		{name: "test", opts: []Option{TestContextFunc(func(ctx context.Context) (bool, error) {
+			err := honours(ctx)
+			return err == nil, err
+		})}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := ContinualSuccess(append(tc.opts, Timeout(100*time.Millisecond), Gap(1*time.Millisecond))...)
+			err := c.Run()
+			eqErr(t, nil, err)
+		})
+	}
+}
+
+func TestRunContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	c := InitialSuccess(
+		ContextFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}),
+		Timeout(10*time.Second),
+	)
+	err := c.RunContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("exp: %v, err: %v", context.Canceled, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected prompt cancellation, took %s", elapsed)
+	}
+}
+
+func TestContext_Funcs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []Option
+		exp  error
+	}{
+		{
+			name: "bool deadline",
+			opts: []Option{
+				BoolContextFunc(func(ctx context.Context) bool {
+					<-ctx.Done()
+					return false
+				}),
+				Timeout(100 * time.Millisecond),
+			},
+			exp: ErrTimeoutExceeded,
+		},
+		{
+			name: "error deadline",
+			opts: []Option{
+				ContextFunc(func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}),
+				Timeout(100 * time.Millisecond),
+			},
+			exp: fmt.Errorf("%s: %w", ErrTimeoutExceeded.Error(), context.DeadlineExceeded),
+		},
+		{
+			name: "test passes",
+			opts: []Option{
+				TestContextFunc(func(ctx context.Context) (bool, error) {
+					return ctx.Err() == nil, nil
+				}),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := InitialSuccess(tc.opts...)
+			err := c.Run()
+			eqErr(t, tc.exp, err)
+		})
+	}
+}
+
+func TestTimeoutAndAttempts(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []Option
+		exp  error
+	}{
+		{
+			name: "attempts first",
+			opts: []Option{
+				BoolFunc(boolFnFalse),
+				Timeout(10 * time.Second),
+				Attempts(3),
+				Gap(1 * time.Millisecond),
+			},
+			exp: ErrAttemptsExceeded,
+		},
+		{
+			name: "timeout first",
+			opts: []Option{
+				BoolFunc(boolFnFalse),
+				Attempts(1000),
+				Timeout(100 * time.Millisecond),
+			},
+			exp: ErrTimeoutExceeded,
+		},
+		{
+			name: "timeout first with short gap",
+			opts: []Option{
+				BoolFunc(boolFnFalse),
+				Attempts(1000),
+				Timeout(100 * time.Millisecond),
+				Gap(1 * time.Millisecond),
+			},
+			exp: ErrTimeoutExceeded,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			c := InitialSuccess(tc.opts...)
+			err := c.Run()
+			eqErr(t, tc.exp, err)
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Fatalf("expected first bound to apply, took %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestAttemptTimeout(t *testing.T) {
+	t.Parallel()
+
+	slow := func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+		case <-time.After(10 * time.Second):
+		}
+		return nil
+	}
+
+	t.Run("initial", func(t *testing.T) {
+		start := time.Now()
+		c := InitialSuccess(
+			ContextFunc(slow),
+			AttemptTimeout(10*time.Millisecond),
+			Attempts(3),
+			Gap(1*time.Millisecond),
+		)
+		err := c.Run()
+		eqErr(t, fmt.Errorf("%s: %w", ErrAttemptsExceeded.Error(), ErrAttemptTimeout), err)
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Fatalf("expected slow attempts to be abandoned, took %s", elapsed)
+		}
+	})
+
+	t.Run("continual", func(t *testing.T) {
+		c := ContinualSuccess(
+			TestContextFunc(func(ctx context.Context) (bool, error) {
+				return true, slow(ctx)
+			}),
+			AttemptTimeout(10*time.Millisecond),
+		)
+		err := c.Run()
+		eqErr(t, fmt.Errorf("%s: %w", ErrConditionUnsatisfied.Error(), ErrAttemptTimeout), err)
+	})
+
+	t.Run("abandoned", func(t *testing.T) {
+		c := InitialSuccess(
+			BoolContextFunc(func(ctx context.Context) bool {
+				time.Sleep(time.Second)
+				return true
+			}),
+			AttemptTimeout(10*time.Millisecond),
+			Attempts(2),
+			Gap(1*time.Millisecond),
+		)
+		err := c.Run()
+		eqErr(t, ErrAttemptsExceeded, err)
+	})
+
+	t.Run("fast", func(t *testing.T) {
+		c := InitialSuccess(
+			ErrorFunc(errFnNil),
+			AttemptTimeout(time.Second),
+		)
+		err := c.Run()
+		eqErr(t, nil, err)
+	})
+
+	t.Run("run deadline", func(t *testing.T) {
+		c := ContinualSuccess(
+			ErrorFunc(func() error {
+				time.Sleep(40 * time.Millisecond)
+				return nil
+			}),
+			Timeout(100*time.Millisecond),
+			AttemptTimeout(80*time.Millisecond),
+			Gap(1*time.Millisecond),
+		)
+		err := c.Run()
+		eqErr(t, nil, err)
+	})
+}